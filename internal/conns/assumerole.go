@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// assumeRoleCredentialsProvider returns a credentials provider that assumes the specified IAM roles in order,
+// starting from the credentials in the specified configuration.
+func assumeRoleCredentialsProvider(ctx context.Context, cfg aws.Config, stsEndpoint, stsRegion string, roles []awsbase.AssumeRole) aws.CredentialsProvider {
+	creds := cfg.Credentials
+
+	for i, ar := range roles {
+		tflog.Debug(ctx, "Configuring IAM Role chaining", map[string]any{
+			"tf_aws.assume_role.index":        i,
+			"tf_aws.assume_role.role_arn":     ar.RoleARN,
+			"tf_aws.assume_role.session_name": ar.SessionName,
+		})
+
+		cfg.Credentials = creds
+		client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+			if stsEndpoint != "" {
+				o.BaseEndpoint = aws.String(stsEndpoint)
+			}
+		})
+
+		creds = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.Duration = ar.Duration
+			o.RoleSessionName = ar.SessionName
+			o.TransitiveTagKeys = ar.TransitiveTagKeys
+
+			if ar.ExternalID != "" {
+				o.ExternalID = aws.String(ar.ExternalID)
+			}
+			if ar.Policy != "" {
+				o.Policy = aws.String(ar.Policy)
+			}
+			for _, v := range ar.PolicyARNs {
+				o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{
+					Arn: aws.String(v),
+				})
+			}
+			if ar.SourceIdentity != "" {
+				o.SourceIdentity = aws.String(ar.SourceIdentity)
+			}
+			for _, k := range slices.Sorted(maps.Keys(ar.Tags)) {
+				o.Tags = append(o.Tags, ststypes.Tag{
+					Key:   aws.String(k),
+					Value: aws.String(ar.Tags[k]),
+				})
+			}
+		}))
+	}
+
+	return creds
+}
+
+// assumeRoleAccountID returns the AWS account ID of the last of the specified IAM roles.
+func assumeRoleAccountID(roles []awsbase.AssumeRole) string {
+	if len(roles) == 0 {
+		return ""
+	}
+
+	v, err := arn.Parse(roles[len(roles)-1].RoleARN)
+	if err != nil {
+		return ""
+	}
+
+	return v.AccountID
+}
+
+// AssumeRoleARNAccountID returns the AWS account ID that a resource is managed in for the specified `assume_role_arn` value.
+// An empty value means the provider's account, as returned by the specified client.
+func AssumeRoleARNAccountID(ctx context.Context, c interface{ AccountID(context.Context) string }, roleARN string) string {
+	if roleARN == "" {
+		return c.AccountID(WithOverrideAssumeRoleARN(ctx, ""))
+	}
+
+	return assumeRoleAccountID([]awsbase.AssumeRole{{RoleARN: roleARN}})
+}
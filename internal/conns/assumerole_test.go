@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestAssumeRoleCredentialsProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	credentialRE := regexp.MustCompile(`Credential=([^/]+)/`)
+
+	type call struct {
+		accessKeyID string // Access key ID the request was signed with.
+		roleARN     string
+		sessionName string
+	}
+	var (
+		lock  sync.Mutex
+		calls []call
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var accessKeyID string
+		if m := credentialRE.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+			accessKeyID = m[1]
+		}
+
+		lock.Lock()
+		calls = append(calls, call{
+			accessKeyID: accessKeyID,
+			roleARN:     r.Form.Get("RoleArn"),
+			sessionName: r.Form.Get("RoleSessionName"),
+		})
+		n := len(calls)
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>ASSUMED%[1]d</AccessKeyId>
+      <SecretAccessKey>secret</SecretAccessKey>
+      <SessionToken>token</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/example/session</Arn>
+      <AssumedRoleId>AROAEXAMPLE:session</AssumedRoleId>
+    </AssumedRoleUser>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>%[1]d</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`, n)
+	}))
+	defer server.Close()
+
+	cfg := aws.Config{
+		Credentials: credentials.NewStaticCredentialsProvider("BASE", "secret", ""),
+		Region:      "us-west-2", //lintignore:AWSAT003
+	}
+	roles := []awsbase.AssumeRole{
+		{
+			RoleARN:     "arn:aws:iam::111111111111:role/first", //lintignore:AWSAT005
+			SessionName: "first",
+		},
+		{
+			RoleARN:     "arn:aws:iam::222222222222:role/second", //lintignore:AWSAT005
+			SessionName: "second",
+		},
+	}
+
+	creds := assumeRoleCredentialsProvider(ctx, cfg, server.URL, "", roles)
+
+	got, err := creds.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := got.AccessKeyID, "ASSUMED2"; got != want {
+		t.Errorf("access key ID: got %q, want %q", got, want)
+	}
+
+	// Each role is assumed using the credentials of the previous role.
+	want := []call{
+		{accessKeyID: "BASE", roleARN: roles[0].RoleARN, sessionName: "first"},
+		{accessKeyID: "ASSUMED1", roleARN: roles[1].RoleARN, sessionName: "second"},
+	}
+	if diff := cmp.Diff(calls, want, cmp.AllowUnexported(call{})); diff != "" {
+		t.Errorf("unexpected STS calls difference: %s", diff)
+	}
+
+	// The provider's own credentials are unchanged.
+	base, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := base.AccessKeyID, "BASE"; got != want {
+		t.Errorf("base access key ID: got %q, want %q", got, want)
+	}
+}
+
+func TestAssumeRoleAccountID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		roles []awsbase.AssumeRole
+		want  string
+	}{
+		"none": {},
+		"single": {
+			roles: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/example"}, //lintignore:AWSAT005
+			},
+			want: "111111111111",
+		},
+		"chained": {
+			roles: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/first"},  //lintignore:AWSAT005
+				{RoleARN: "arn:aws:iam::222222222222:role/second"}, //lintignore:AWSAT005
+			},
+			want: "222222222222",
+		},
+		"invalid ARN": {
+			roles: []awsbase.AssumeRole{
+				{RoleARN: "invalid"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := assumeRoleAccountID(testCase.roles); got != testCase.want {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAssumeRoleARNAccountID(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := &AWSClient{accountID: "123456789012"}
+
+	testCases := map[string]struct {
+		roleARN string
+		want    string
+	}{
+		"empty": {
+			want: "123456789012",
+		},
+		"role": {
+			roleARN: "arn:aws:iam::111111111111:role/example", //lintignore:AWSAT005
+			want:    "111111111111",
+		},
+		"invalid ARN": {
+			roleARN: "invalid",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := AssumeRoleARNAccountID(ctx, client, testCase.roleARN); got != testCase.want {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiAuditRecord is a single line of the API audit log.
+// Request and response bodies are never recorded.
+type apiAuditRecord struct {
+	Time               time.Time `json:"time"`
+	Service            string    `json:"service"`
+	Operation          string    `json:"operation"`
+	Region             string    `json:"region,omitempty"`
+	ServicePackageName string    `json:"service_package,omitempty"`
+	ResourceType       string    `json:"resource_type,omitempty"`
+	ResourceID         string    `json:"resource_id,omitempty"`
+	RequestID          string    `json:"request_id,omitempty"`
+	HTTPStatusCode     int       `json:"http_status_code,omitempty"`
+	LatencyMillis      int64     `json:"latency_ms"`
+	Retries            int       `json:"retries"`
+	ErrorCode          string    `json:"error_code,omitempty"`
+}
+
+// apiAuditLogger writes a JSON-lines record of each AWS API call.
+type apiAuditLogger struct {
+	now func() time.Time
+
+	lock sync.Mutex
+	w    io.Writer
+}
+
+func newAPIAuditLogger(w io.Writer) *apiAuditLogger {
+	return &apiAuditLogger{
+		now: time.Now,
+		w:   w,
+	}
+}
+
+var (
+	apiAuditLoggersLock sync.Mutex
+	apiAuditLoggers     = make(map[string]*apiAuditLogger) // File path -> API audit logger.
+)
+
+// openAPIAuditLogger returns an API audit logger that appends to the specified file, creating it if necessary.
+// The file is opened once and its logger is shared by all provider configurations that log to the same path.
+// Writes are unbuffered, and the file is closed when the provider process exits.
+func openAPIAuditLogger(path string) (*apiAuditLogger, error) {
+	if v, err := filepath.Abs(path); err == nil {
+		path = v
+	}
+
+	apiAuditLoggersLock.Lock()
+	defer apiAuditLoggersLock.Unlock()
+
+	if l, ok := apiAuditLoggers[path]; ok {
+		return l, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	l := newAPIAuditLogger(f)
+	apiAuditLoggers[path] = l
+
+	return l, nil
+}
+
+// addToStack is an AWS SDK for Go v2 API option that adds the audit logger to an operation's middleware stack.
+// The audit logger runs before the retry middleware so that one record is written per operation, covering all attempts.
+func (l *apiAuditLogger) addToStack(stack *middleware.Stack) error {
+	return stack.Initialize.Add(l, middleware.After)
+}
+
+func (*apiAuditLogger) ID() string {
+	return "TerraformAPIAuditLogger"
+}
+
+func (l *apiAuditLogger) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	start := l.now()
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	record := apiAuditRecord{
+		Time:          start.UTC(),
+		Service:       awsmiddleware.GetServiceID(ctx),
+		Operation:     awsmiddleware.GetOperationName(ctx),
+		Region:        awsmiddleware.GetRegion(ctx),
+		LatencyMillis: l.now().Sub(start).Milliseconds(),
+	}
+	if inContext, ok := FromContext(ctx); ok {
+		record.ServicePackageName = inContext.ServicePackageName()
+		record.ResourceType = inContext.TypeName()
+		record.ResourceID = inContext.ResourceID()
+	}
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		record.RequestID = v
+	}
+	if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && v != nil {
+		record.HTTPStatusCode = v.StatusCode
+	}
+	if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 1 {
+		record.Retries = len(v.Results) - 1
+	}
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			record.ErrorCode = apiErr.ErrorCode()
+		} else {
+			record.ErrorCode = "ClientError"
+		}
+	}
+
+	if err := l.write(record); err != nil {
+		tflog.Warn(ctx, "Writing API audit log record", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	return out, metadata, err
+}
+
+func (l *apiAuditLogger) write(record apiAuditRecord) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	_, err = l.w.Write(b)
+
+	return err
+}
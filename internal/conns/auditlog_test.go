@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAPIAuditLogger(t *testing.T) {
+	t.Parallel()
+
+	const (
+		getCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/example</Arn>
+    <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+		throttlingResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>fedcba98-7654-3210-fedc-ba9876543210</RequestId>
+</ErrorResponse>`
+		accessDeniedResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>AccessDenied</Code>
+    <Message>Not authorized</Message>
+  </Error>
+  <RequestId>00000000-1111-2222-3333-444444444444</RequestId>
+</ErrorResponse>`
+	)
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		switch requests.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(throttlingResponse))
+		case 2:
+			w.Header().Set("X-Amzn-Requestid", "01234567-89ab-cdef-0123-456789abcdef")
+			w.Write([]byte(getCallerIdentityResponse))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(accessDeniedResponse))
+		}
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := newAPIAuditLogger(&buf)
+
+	conn := sts.New(sts.Options{
+		APIOptions:   []func(*middleware.Stack) error{logger.addToStack},
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  aws.AnonymousCredentials{},
+		Region:       "us-west-2", //lintignore:AWSAT003
+		Retryer: retry.NewStandard(func(o *retry.StandardOptions) {
+			o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 0, nil })
+		}),
+	})
+
+	ctx := NewResourceContext(context.Background(), "sts", "Example", "aws_example", "")
+	ctx = WithResourceID(ctx, "example-1")
+
+	if _, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("GetCallerIdentity: %s", err)
+	}
+	if _, err := conn.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{}); err == nil {
+		t.Fatal("GetCallerIdentity: expected error")
+	}
+
+	var got []apiAuditRecord
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var record apiAuditRecord
+		if err := decoder.Decode(&record); err != nil {
+			t.Fatalf("decoding record: %s", err)
+		}
+		got = append(got, record)
+	}
+
+	want := []apiAuditRecord{
+		{
+			Service:            "STS",
+			Operation:          "GetCallerIdentity",
+			Region:             "us-west-2", //lintignore:AWSAT003
+			ServicePackageName: "sts",
+			ResourceType:       "aws_example",
+			ResourceID:         "example-1",
+			RequestID:          "01234567-89ab-cdef-0123-456789abcdef",
+			HTTPStatusCode:     http.StatusOK,
+			Retries:            1,
+		},
+		{
+			Service:        "STS",
+			Operation:      "GetCallerIdentity",
+			Region:         "us-west-2", //lintignore:AWSAT003
+			RequestID:      "00000000-1111-2222-3333-444444444444",
+			HTTPStatusCode: http.StatusForbidden,
+			ErrorCode:      "AccessDenied",
+		},
+	}
+
+	if diff := cmp.Diff(got, want, cmpopts.IgnoreFields(apiAuditRecord{}, "Time", "LatencyMillis")); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestOpenAPIAuditLogger(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	first, err := openAPIAuditLogger(path)
+	if err != nil {
+		t.Fatalf("opening API audit log: %s", err)
+	}
+	second, err := openAPIAuditLogger(path)
+	if err != nil {
+		t.Fatalf("opening API audit log: %s", err)
+	}
+
+	if first != second {
+		t.Error("expected API audit loggers for the same path to be shared")
+	}
+
+	if err := first.write(apiAuditRecord{Service: "STS", Operation: "GetCallerIdentity"}); err != nil {
+		t.Fatalf("writing API audit log record: %s", err)
+	}
+
+	if err := second.write(apiAuditRecord{Service: "STS", Operation: "GetCallerIdentity"}); err != nil {
+		t.Fatalf("writing API audit log record: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading API audit log: %s", err)
+	}
+	if got, want := bytes.Count(b, []byte("\n")), 2; got != want {
+		t.Errorf("API audit log has %d records, want %d", got, want)
+	}
+}
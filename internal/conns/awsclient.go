@@ -10,6 +10,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -28,16 +30,27 @@ import (
 
 type AWSClient struct {
 	accountID                 string
+	allowedAccountIDs         []string // From provider configuration.
 	awsConfig                 *aws.Config
 	clients                   map[string]map[string]any // Region -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
+	forbiddenAccountIDs       []string          // From provider configuration.
 	httpClient                *http.Client
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	permissionPreflight       bool // From provider configuration.
+	preflightLock             sync.Mutex
+	preflightPrincipals       map[string]string                  // Credentials key -> IAM principal ARN, from permission preflight.
+	preflightResults          map[string][]string                // IAM principal ARN and actions -> denied actions, from permission preflight.
+	roleCredentials           map[string]aws.CredentialsProvider // IAM Role ARN -> credentials, from per-resource IAM Role overrides.
+	roleCredentialsLock       sync.Mutex
+	serviceAccountIDs         map[string]string                  // Service package name -> AWS account ID, from per-service IAM Roles.
+	serviceCredentials        map[string]aws.CredentialsProvider // Service package name -> credentials, from per-service IAM Roles.
 	servicePackages           map[string]ServicePackage
+	serviceRateLimiters       map[string]*serviceRateLimiter // Service package name -> rate limiter, from provider configuration.
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
@@ -67,18 +80,39 @@ func (c *AWSClient) TerraformVersion(_ context.Context) string {
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
-func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider {
+// If the currently in-process operation has defined a per-resource IAM Role override,
+// credentials for that role are returned.
+func (c *AWSClient) CredentialsProvider(ctx context.Context) aws.CredentialsProvider {
 	if c.awsConfig == nil {
 		return nil
 	}
+	if roleARN := overrideAssumeRoleARN(ctx); roleARN != "" {
+		return c.assumeRoleCredentials(ctx, roleARN)
+	}
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the default tags configuration.
+// If the currently in-process operation is for a resource, only the default tags that apply to its resource type and service are returned.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return c.defaultTagsConfig.ForResource(inContext.TypeName(), inContext.ServicePackageName())
+	}
+	return c.defaultTagsConfig
+}
+
+// ProviderDefaultTagsConfig returns the default tags configuration as set in the provider configuration.
+// Unlike DefaultTagsConfig, rules and exclusions are never resolved for the currently in-process resource.
+func (c *AWSClient) ProviderDefaultTagsConfig(context.Context) *tftags.DefaultConfig {
 	return c.defaultTagsConfig
 }
 
-func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
+// IgnoreTagsConfig returns the ignore tags configuration.
+// If the currently in-process operation is for a resource, only the rules that apply to its resource type are returned.
+func (c *AWSClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return c.ignoreTagsConfig.ForResource(inContext.TypeName())
+	}
 	return c.ignoreTagsConfig
 }
 
@@ -91,10 +125,42 @@ func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-
 }
 
 // AccountID returns the configured AWS account ID.
-func (c *AWSClient) AccountID(context.Context) string {
+// If the currently in-process operation has defined a per-resource IAM Role override
+// or its service uses a per-service IAM Role, the account ID of that role is returned.
+func (c *AWSClient) AccountID(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		if roleARN := inContext.OverrideAssumeRoleARN(); roleARN != "" {
+			if v := assumeRoleAccountID([]awsbase.AssumeRole{{RoleARN: roleARN}}); v != "" {
+				return v
+			}
+		}
+		if v, ok := c.serviceAccountIDs[inContext.ServicePackageName()]; ok && v != "" {
+			return v
+		}
+	}
+
 	return c.accountID
 }
 
+// VerifyAssumeRoleARNAllowed verifies that the AWS account ID of the specified per-resource IAM Role override
+// is allowed by the provider's `allowed_account_ids` and `forbidden_account_ids` configuration.
+func (c *AWSClient) VerifyAssumeRoleARNAllowed(roleARN string) error {
+	accountID := assumeRoleAccountID([]awsbase.AssumeRole{{RoleARN: roleARN}})
+	if accountID == "" {
+		return fmt.Errorf("parsing AWS account ID from IAM Role ARN (%s)", roleARN)
+	}
+
+	config := awsbase.Config{
+		AllowedAccountIds:   c.allowedAccountIDs,
+		ForbiddenAccountIds: c.forbiddenAccountIDs,
+	}
+	if err := config.VerifyAccountIDAllowed(accountID); err != nil {
+		return fmt.Errorf("IAM Role (%s): %w", roleARN, err)
+	}
+
+	return nil
+}
+
 // Partition returns the ID of the configured AWS partition.
 func (c *AWSClient) Partition(context.Context) string {
 	return c.partition.ID()
@@ -356,17 +422,70 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
+	creds, hasCredentials := c.overrideCredentials(ctx, servicePackageName)
+	limiter, hasRateLimiter := c.serviceRateLimiters[servicePackageName]
+	if hasCredentials || hasRateLimiter {
+		cfg := c.awsConfig.Copy()
+		if hasCredentials {
+			cfg.Credentials = creds
+		}
+		if hasRateLimiter {
+			cfg.APIOptions = append(slices.Clone(cfg.APIOptions), limiter.addToStack)
+		}
+		m["aws_sdkv2_config"] = &cfg
+	}
+
 	return m
 }
 
+// overrideCredentials returns any credentials that replace the provider's credentials for the specified service's API calls.
+// A per-resource IAM Role override takes precedence over a per-service IAM Role.
+func (c *AWSClient) overrideCredentials(ctx context.Context, servicePackageName string) (aws.CredentialsProvider, bool) {
+	if roleARN := overrideAssumeRoleARN(ctx); roleARN != "" {
+		return c.assumeRoleCredentials(ctx, roleARN), true
+	}
+
+	creds, ok := c.serviceCredentials[servicePackageName]
+
+	return creds, ok
+}
+
+// assumeRoleCredentials returns credentials for the specified per-resource IAM Role override.
+// The role is assumed using the provider's configured credentials and the credentials are cached per role.
+func (c *AWSClient) assumeRoleCredentials(ctx context.Context, roleARN string) aws.CredentialsProvider {
+	c.roleCredentialsLock.Lock()
+	defer c.roleCredentialsLock.Unlock()
+
+	if creds, ok := c.roleCredentials[roleARN]; ok {
+		return creds
+	}
+
+	if c.roleCredentials == nil {
+		c.roleCredentials = make(map[string]aws.CredentialsProvider)
+	}
+	creds := assumeRoleCredentialsProvider(ctx, *c.awsConfig, c.endpoints[names.STS], c.stsRegion, []awsbase.AssumeRole{{RoleARN: roleARN}})
+	c.roleCredentials[roleARN] = creds
+
+	return creds
+}
+
+// overrideAssumeRoleARN returns any per-resource IAM Role override in effect for the currently in-process operation.
+func overrideAssumeRoleARN(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		return inContext.OverrideAssumeRoleARN()
+	}
+
+	return ""
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty and there is no per-resource IAM Role override) is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 	region := c.Region(ctx)
 
-	isDefault := len(extra) == 0
+	isDefault := len(extra) == 0 && overrideAssumeRoleARN(ctx) == ""
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
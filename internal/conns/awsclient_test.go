@@ -598,3 +598,106 @@ func TestAWSClientRegionalARNWithRegion(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientAccountID(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	client := &AWSClient{
+		accountID: "123456789012",
+		serviceAccountIDs: map[string]string{
+			"svc": "111111111111",
+		},
+	}
+	testCases := []struct {
+		Name                  string
+		ServicePackageName    string
+		OverrideAssumeRoleARN string
+		Expected              string
+	}{
+		{
+			Name:     "no resource context",
+			Expected: "123456789012",
+		},
+		{
+			Name:               "provider account",
+			ServicePackageName: "other",
+			Expected:           "123456789012",
+		},
+		{
+			Name:               "per-service IAM Role",
+			ServicePackageName: "svc",
+			Expected:           "111111111111",
+		},
+		{
+			Name:                  "per-resource IAM Role",
+			ServicePackageName:    "other",
+			OverrideAssumeRoleARN: "arn:aws:iam::222222222222:role/example", //lintignore:AWSAT005
+			Expected:              "222222222222",
+		},
+		{
+			Name:                  "per-resource IAM Role takes precedence",
+			ServicePackageName:    "svc",
+			OverrideAssumeRoleARN: "arn:aws:iam::222222222222:role/example", //lintignore:AWSAT005
+			Expected:              "222222222222",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := ctx
+			if testCase.ServicePackageName != "" {
+				ctx = NewResourceContext(ctx, testCase.ServicePackageName, "Test", "aws_test_test", "")
+				ctx = WithOverrideAssumeRoleARN(ctx, testCase.OverrideAssumeRoleARN)
+			}
+
+			if got := client.AccountID(ctx); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientAssumeRoleOverrideCredentials(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	var base aws.CredentialsProvider = aws.AnonymousCredentials{}
+	client := &AWSClient{
+		awsConfig: &aws.Config{
+			Credentials: base,
+			Region:      "us-west-2", //lintignore:AWSAT003
+		},
+	}
+	const (
+		role1 = "arn:aws:iam::111111111111:role/first"  //lintignore:AWSAT005
+		role2 = "arn:aws:iam::222222222222:role/second" //lintignore:AWSAT005
+	)
+
+	ctx = NewResourceContext(ctx, "svc", "Test", "aws_test_test", "")
+	if got := client.CredentialsProvider(ctx); got != base {
+		t.Errorf("no override: got %v, expected provider credentials", got)
+	}
+
+	ctx1 := WithOverrideAssumeRoleARN(ctx, role1)
+	creds1 := client.CredentialsProvider(ctx1)
+	if creds1 == base {
+		t.Fatal("override: got provider credentials")
+	}
+	if got := client.CredentialsProvider(ctx1); got != creds1 {
+		t.Error("override: credentials are not cached per IAM Role")
+	}
+	if got := client.CredentialsProvider(WithOverrideAssumeRoleARN(ctx, role2)); got == creds1 {
+		t.Error("override: credentials are shared between IAM Roles")
+	}
+
+	cfg := client.apiClientConfig(ctx1, "svc")["aws_sdkv2_config"].(*aws.Config)
+	if got := cfg.Credentials; got != creds1 {
+		t.Errorf("API client configuration: got %v, expected IAM Role credentials", got)
+	}
+	if got := client.awsConfig.Credentials; got != base {
+		t.Errorf("provider configuration: got %v, expected provider credentials", got)
+	}
+}
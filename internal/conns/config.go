@@ -28,6 +28,7 @@ import (
 
 type Config struct {
 	AccessKey                      string
+	APIAuditLogPath                string
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
@@ -44,12 +45,15 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	PermissionPreflight            bool
 	Profile                        string
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceAssumeRoles             map[string][]awsbase.AssumeRole
+	ServiceRateLimits              map[string]ServiceRateLimit
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -157,6 +161,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if c.APIAuditLogPath != "" {
+		auditLogger, err := openAPIAuditLogger(c.APIAuditLogPath)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening API audit log: %s", err)
+		}
+		cfg.APIOptions = append(cfg.APIOptions, auditLogger.addToStack)
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -187,6 +199,29 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, sdkdiag.AppendErrorf(diags, "%s", err.Error())
 	}
 
+	serviceCredentials := make(map[string]aws.CredentialsProvider, len(c.ServiceAssumeRoles))
+	serviceAccountIDs := make(map[string]string, len(c.ServiceAssumeRoles))
+	for servicePackageName, roles := range c.ServiceAssumeRoles {
+		creds := assumeRoleCredentialsProvider(ctx, cfg, c.Endpoints[names.STS], c.STSRegion, roles)
+
+		if !skipCredsValidation {
+			tflog.Debug(ctx, "Validating per-service IAM Role credentials", map[string]any{
+				"tf_aws.service_package": servicePackageName,
+			})
+			if _, err := creds.Retrieve(ctx); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "assuming IAM Role for service %s: %s", servicePackageName, err)
+			}
+		}
+
+		serviceAccountID := assumeRoleAccountID(roles)
+		if err := awsbaseConfig.VerifyAccountIDAllowed(serviceAccountID); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "service %s: %s", servicePackageName, err)
+		}
+
+		serviceCredentials[servicePackageName] = creds
+		serviceAccountIDs[servicePackageName] = serviceAccountID
+	}
+
 	for _, partition := range endpoints.DefaultPartitions() {
 		if partition.ID() == partitionID {
 			client.partition = partition
@@ -206,9 +241,22 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			return nil, diags
 		}
 		c.TagPolicyConfig.RequiredTags = reqTags
+
+		tflog.Debug(ctx, "Retrieving effective tag policy")
+		tagKeyPolicies, err := tagpolicy.GetTagKeyPolicies(ctx, cfg)
+		if err != nil {
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"Retrieving Effective Tag Policy",
+				`Failed to retrieve the effective organizations tag policy. Tag key capitalization and allowed tag values will not be validated. `+
+					`Ensure the calling principal has the "organizations:DescribeEffectivePolicy" IAM permission.`+
+					fmt.Sprintf("\n\nOriginal error: %s", err)))
+		}
+		c.TagPolicyConfig.TagKeyPolicies = tagKeyPolicies
 	}
 
 	client.accountID = accountID
+	client.allowedAccountIDs = c.AllowedAccountIds
+	client.forbiddenAccountIDs = c.ForbiddenAccountIds
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.tagPolicyConfig = c.TagPolicyConfig
@@ -219,8 +267,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.permissionPreflight = c.PermissionPreflight
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceAccountIDs = serviceAccountIDs
+	client.serviceCredentials = serviceCredentials
+	client.serviceRateLimiters = make(map[string]*serviceRateLimiter, len(c.ServiceRateLimits))
+	for servicePackageName, limit := range c.ServiceRateLimits {
+		client.serviceRateLimiters[servicePackageName] = newServiceRateLimiter(limit)
+	}
 	client.stsRegion = c.STSRegion
 
 	return client, diags
@@ -55,11 +55,18 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	overrideRegion     string // Any currently in effect per-resource Region override.
-	resourceName       string // Friendly resource name, e.g. "Subnet"
-	typeName           string // Resource type name, e.g. "aws_iam_role"
-	servicePackageName string // Canonical name defined as a constant in names package
-	vcrEnabled         bool   // Whether VCR testing is enabled
+	overrideAssumeRoleARN string // Any currently in effect per-resource IAM Role override.
+	overrideRegion        string // Any currently in effect per-resource Region override.
+	resourceID            string // Any known ID of the resource, e.g. "subnet-0123456789abcdef0"
+	resourceName          string // Friendly resource name, e.g. "Subnet"
+	typeName              string // Resource type name, e.g. "aws_iam_role"
+	servicePackageName    string // Canonical name defined as a constant in names package
+	vcrEnabled            bool   // Whether VCR testing is enabled
+}
+
+// OverrideAssumeRoleARN returns any currently in effect per-resource IAM Role override.
+func (c *InContext) OverrideAssumeRoleARN() string {
+	return c.overrideAssumeRoleARN
 }
 
 // OverrideRegion returns any currently in effect per-resource Region override.
@@ -67,6 +74,11 @@ func (c *InContext) OverrideRegion() string {
 	return c.overrideRegion
 }
 
+// ResourceID returns any known ID of the resource, e.g. "subnet-0123456789abcdef0".
+func (c *InContext) ResourceID() string {
+	return c.resourceID
+}
+
 // ResourceName returns the friendly resource name, e.g. "Subnet".
 func (c *InContext) ResourceName() string {
 	return c.resourceName
@@ -99,6 +111,32 @@ func NewResourceContext(ctx context.Context, servicePackageName, resourceName, t
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+// WithOverrideAssumeRoleARN returns a copy of the specified resource Context in which the per-resource IAM Role override is in effect.
+func WithOverrideAssumeRoleARN(ctx context.Context, roleARN string) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	w := *v
+	w.overrideAssumeRoleARN = roleARN
+
+	return context.WithValue(ctx, contextKey, &w)
+}
+
+// WithResourceID returns a copy of the specified resource Context in which the resource's ID is known.
+func WithResourceID(ctx context.Context, id string) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	w := *v
+	w.resourceID = id
+
+	return context.WithValue(ctx, contextKey, &w)
+}
+
 func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
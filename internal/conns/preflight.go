@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// PermissionPreflight returns whether IAM permissions are checked when planning resource changes.
+func (c *AWSClient) PermissionPreflight(context.Context) bool {
+	return c.permissionPreflight
+}
+
+// DeniedActions returns those of the specified IAM actions that the caller is not allowed to perform,
+// as determined by IAM policy simulation.
+// The caller is the IAM principal whose credentials are used by the currently in-process operation,
+// taking any per-service or per-resource IAM Role into account.
+// Actions of the resource's own service are simulated against the specified resource ARN, if known.
+// Results are cached.
+func (c *AWSClient) DeniedActions(ctx context.Context, actions []string, resourceARN string) ([]string, error) {
+	if len(actions) == 0 {
+		return nil, nil
+	}
+
+	principalARN, err := c.preflightPrincipalARN(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The account root user is allowed to perform all actions.
+	if principalARN == "" {
+		return nil, nil
+	}
+
+	key := principalARN + " " + resourceARN + " " + strings.Join(actions, ",")
+
+	c.preflightLock.Lock()
+	denied, ok := c.preflightResults[key]
+	c.preflightLock.Unlock()
+
+	if ok {
+		return denied, nil
+	}
+
+	conn, err := client[*iam.Client](ctx, c, names.IAM, c.preflightClientConfig(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	simulations := make(map[string][]string)
+	for _, action := range actions {
+		v := simulationResourceARN(action, resourceARN)
+		simulations[v] = append(simulations[v], action)
+	}
+
+	for _, simulationARN := range slices.Sorted(maps.Keys(simulations)) {
+		input := iam.SimulatePrincipalPolicyInput{
+			ActionNames:     simulations[simulationARN],
+			PolicySourceArn: aws.String(principalARN),
+		}
+		if simulationARN != "" {
+			input.ResourceArns = []string{simulationARN}
+		}
+
+		pages := iam.NewSimulatePrincipalPolicyPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("simulating IAM policies for %s: %w", principalARN, err)
+			}
+
+			for _, v := range page.EvaluationResults {
+				if isDeniedEvaluationResult(v, simulationARN) {
+					denied = append(denied, aws.ToString(v.EvalActionName))
+				}
+			}
+		}
+	}
+
+	slices.Sort(denied)
+
+	c.preflightLock.Lock()
+	if c.preflightResults == nil {
+		c.preflightResults = make(map[string][]string)
+	}
+	c.preflightResults[key] = denied
+	c.preflightLock.Unlock()
+
+	return denied, nil
+}
+
+// simulationResourceARN returns the ARN of the resource that the specified IAM action is simulated against.
+// Actions of other services than the resource's, such as `iam:PassRole`, act on other resources
+// and are simulated against all resources, as are all actions if the resource's ARN is not known.
+func simulationResourceARN(action, resourceARN string) string {
+	if resourceARN == "" {
+		return ""
+	}
+
+	v, err := arn.Parse(resourceARN)
+	if err != nil {
+		return ""
+	}
+
+	if service, _, _ := strings.Cut(action, ":"); !strings.EqualFold(service, v.Service) {
+		return ""
+	}
+
+	return resourceARN
+}
+
+// isDeniedEvaluationResult returns whether an IAM policy simulation result definitively denies the action.
+// A simulation against all resources, or without values for the context keys of policy conditions,
+// cannot tell whether statements scoped to specific resources or conditions allow the action,
+// so only an explicit deny is then definitive.
+func isDeniedEvaluationResult(result awstypes.EvaluationResult, resourceARN string) bool {
+	switch result.EvalDecision {
+	case awstypes.PolicyEvaluationDecisionTypeExplicitDeny:
+		return true
+	case awstypes.PolicyEvaluationDecisionTypeImplicitDeny:
+		return resourceARN != "" && len(result.MissingContextValues) == 0
+	default:
+		return false
+	}
+}
+
+// preflightClientConfig returns extra API client configuration parameters that make API calls
+// with the credentials used by the currently in-process operation.
+func (c *AWSClient) preflightClientConfig(ctx context.Context) map[string]any {
+	var servicePackageName string
+	if inContext, ok := FromContext(ctx); ok {
+		servicePackageName = inContext.ServicePackageName()
+	}
+
+	cfg := c.awsConfig.Copy()
+	if creds, ok := c.overrideCredentials(ctx, servicePackageName); ok {
+		cfg.Credentials = creds
+	}
+
+	return map[string]any{
+		"aws_sdkv2_config": &cfg,
+	}
+}
+
+// preflightPrincipalARN returns the ARN of the IAM principal whose credentials are used by the currently in-process operation.
+// An empty string is returned for the account root user.
+func (c *AWSClient) preflightPrincipalARN(ctx context.Context) (string, error) {
+	// Identify the credentials used.
+	key := overrideAssumeRoleARN(ctx)
+	if inContext, ok := FromContext(ctx); ok && key == "" {
+		if _, ok := c.serviceCredentials[inContext.ServicePackageName()]; ok {
+			key = "service/" + inContext.ServicePackageName()
+		}
+	}
+
+	c.preflightLock.Lock()
+	principalARN, ok := c.preflightPrincipals[key]
+	c.preflightLock.Unlock()
+
+	if ok {
+		return principalARN, nil
+	}
+
+	extra := c.preflightClientConfig(ctx)
+	stsConn, err := client[*sts.Client](ctx, c, names.STS, extra)
+	if err != nil {
+		return "", err
+	}
+
+	output, err := stsConn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		return "", fmt.Errorf("reading caller identity: %w", err)
+	}
+
+	principalARN, roleName, err := iamPrincipalARN(aws.ToString(output.Arn))
+	if err != nil {
+		return "", err
+	}
+
+	// An assumed role session's ARN does not include the IAM Role's path.
+	if roleName != "" {
+		iamConn, err := client[*iam.Client](ctx, c, names.IAM, extra)
+		if err != nil {
+			return "", err
+		}
+
+		input := iam.GetRoleInput{
+			RoleName: aws.String(roleName),
+		}
+		output, err := iamConn.GetRole(ctx, &input)
+
+		if err == nil {
+			principalARN = aws.ToString(output.Role.Arn)
+		} else {
+			tflog.Debug(ctx, "Unable to read IAM Role, assuming default path", map[string]any{
+				"tf_aws.iam_role.name": roleName,
+				"error":                err.Error(),
+			})
+		}
+	}
+
+	c.preflightLock.Lock()
+	if c.preflightPrincipals == nil {
+		c.preflightPrincipals = make(map[string]string)
+	}
+	c.preflightPrincipals[key] = principalARN
+	c.preflightLock.Unlock()
+
+	return principalARN, nil
+}
+
+// iamPrincipalARN returns the ARN of the IAM principal that can be used in IAM policy simulation for the specified caller identity ARN.
+// For an assumed role session the IAM Role's name is also returned.
+// An empty ARN is returned for the account root user.
+func iamPrincipalARN(callerARN string) (string, string, error) {
+	v, err := arn.Parse(callerARN)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch resourceType, resourceName, _ := strings.Cut(v.Resource, "/"); {
+	case v.Service == "iam" && v.Resource == "root":
+		return "", "", nil
+	case v.Service == "iam" && (resourceType == "user" || resourceType == "role"):
+		return callerARN, "", nil
+	case v.Service == "sts" && resourceType == "assumed-role":
+		roleName, _, _ := strings.Cut(resourceName, "/")
+		principal := arn.ARN{
+			Partition: v.Partition,
+			Service:   "iam",
+			AccountID: v.AccountID,
+			Resource:  "role/" + roleName,
+		}
+		return principal.String(), roleName, nil
+	default:
+		return "", "", fmt.Errorf("IAM policy simulation is not supported for caller %s", callerARN)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+func TestIAMPrincipalARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		callerARN     string
+		wantPrincipal string
+		wantRoleName  string
+		wantErr       bool
+	}{
+		"user": {
+			callerARN:     "arn:aws:iam::123456789012:user/path/example", //lintignore:AWSAT005
+			wantPrincipal: "arn:aws:iam::123456789012:user/path/example", //lintignore:AWSAT005
+		},
+		"role": {
+			callerARN:     "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+			wantPrincipal: "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+		},
+		"assumed role": {
+			callerARN:     "arn:aws:sts::123456789012:assumed-role/example/session", //lintignore:AWSAT005
+			wantPrincipal: "arn:aws:iam::123456789012:role/example",                 //lintignore:AWSAT005
+			wantRoleName:  "example",
+		},
+		"assumed role other partition": {
+			callerARN:     "arn:aws-cn:sts::123456789012:assumed-role/example/session", //lintignore:AWSAT005
+			wantPrincipal: "arn:aws-cn:iam::123456789012:role/example",                 //lintignore:AWSAT005
+			wantRoleName:  "example",
+		},
+		"root": {
+			callerARN: "arn:aws:iam::123456789012:root", //lintignore:AWSAT005
+		},
+		"federated user": {
+			callerARN: "arn:aws:sts::123456789012:federated-user/example", //lintignore:AWSAT005
+			wantErr:   true,
+		},
+		"invalid ARN": {
+			callerARN: "invalid",
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotPrincipal, gotRoleName, err := iamPrincipalARN(testCase.callerARN)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error: got %v, want error %t", err, want)
+			}
+			if got, want := gotPrincipal, testCase.wantPrincipal; got != want {
+				t.Errorf("principal: got %q, want %q", got, want)
+			}
+			if got, want := gotRoleName, testCase.wantRoleName; got != want {
+				t.Errorf("role name: got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSimulationResourceARN(t *testing.T) {
+	t.Parallel()
+
+	const functionARN = "arn:aws:lambda:us-west-2:123456789012:function:example" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		action      string
+		resourceARN string
+		want        string
+	}{
+		"unknown ARN": {
+			action: "lambda:CreateFunction",
+		},
+		"same service": {
+			action:      "lambda:DeleteFunction",
+			resourceARN: functionARN,
+			want:        functionARN,
+		},
+		"other service": {
+			action:      "iam:PassRole",
+			resourceARN: functionARN,
+		},
+		"invalid ARN": {
+			action:      "lambda:DeleteFunction",
+			resourceARN: "example",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := simulationResourceARN(testCase.action, testCase.resourceARN); got != testCase.want {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestIsDeniedEvaluationResult(t *testing.T) {
+	t.Parallel()
+
+	const bucketARN = "arn:aws:s3:::example" //lintignore:AWSAT005
+
+	testCases := map[string]struct {
+		result      awstypes.EvaluationResult
+		resourceARN string
+		want        bool
+	}{
+		"allowed": {
+			result:      awstypes.EvaluationResult{EvalDecision: awstypes.PolicyEvaluationDecisionTypeAllowed},
+			resourceARN: bucketARN,
+		},
+		"explicit deny": {
+			result: awstypes.EvaluationResult{EvalDecision: awstypes.PolicyEvaluationDecisionTypeExplicitDeny},
+			want:   true,
+		},
+		"implicit deny unknown ARN": {
+			result: awstypes.EvaluationResult{EvalDecision: awstypes.PolicyEvaluationDecisionTypeImplicitDeny},
+		},
+		"implicit deny": {
+			result:      awstypes.EvaluationResult{EvalDecision: awstypes.PolicyEvaluationDecisionTypeImplicitDeny},
+			resourceARN: bucketARN,
+			want:        true,
+		},
+		"implicit deny missing context values": {
+			result: awstypes.EvaluationResult{
+				EvalDecision:         awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+				MissingContextValues: []string{"aws:ResourceTag/Environment"},
+			},
+			resourceARN: bucketARN,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.result.EvalActionName = aws.String("s3:DeleteBucket")
+
+			if got := isDeniedEvaluationResult(testCase.result, testCase.resourceARN); got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ServiceRateLimit is the client-side rate limit and concurrency cap applied to a service's API calls.
+// Zero values disable the corresponding limit.
+type ServiceRateLimit struct {
+	RequestsPerSecond float64 // Sustained request rate.
+	Burst             int     // Maximum number of requests made at once before the sustained rate applies.
+	MaxInFlight       int     // Maximum number of concurrent requests.
+}
+
+// serviceRateLimiter enforces a ServiceRateLimit.
+// It is shared by all of a service's API clients, whatever their Region.
+// Each attempt, including retries, is limited.
+type serviceRateLimiter struct {
+	inFlight chan struct{} // nil if concurrency is unlimited.
+	now      func() time.Time
+
+	lock   sync.Mutex
+	rate   float64 // Tokens per second, 0 if the request rate is unlimited.
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newServiceRateLimiter(limit ServiceRateLimit) *serviceRateLimiter {
+	l := &serviceRateLimiter{
+		now:  time.Now,
+		rate: limit.RequestsPerSecond,
+	}
+
+	if limit.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, limit.MaxInFlight)
+	}
+
+	if l.rate > 0 {
+		l.burst = float64(max(limit.Burst, 1))
+		l.tokens = l.burst
+		l.last = l.now()
+	}
+
+	return l
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (l *serviceRateLimiter) reserve() time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket.
+func (l *serviceRateLimiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.tokens = min(l.burst, l.tokens+1)
+}
+
+// wait blocks until a request may be made or the context is done.
+// If wait returns nil, the caller must call done once the request completes.
+func (l *serviceRateLimiter) wait(ctx context.Context) error {
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if d := l.reserve(); d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			l.cancel()
+			l.done()
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+func (l *serviceRateLimiter) done() {
+	if l.inFlight != nil {
+		<-l.inFlight
+	}
+}
+
+// addToStack is an AWS SDK for Go v2 API option that adds the rate limiter to an operation's middleware stack.
+// The rate limiter runs after the retry middleware so that each attempt is limited and
+// before request signing so that signatures are not invalidated by waiting.
+func (l *serviceRateLimiter) addToStack(stack *middleware.Stack) error {
+	const (
+		retryMiddlewareID = "Retry"
+	)
+
+	if _, ok := stack.Finalize.Get(retryMiddlewareID); ok {
+		return stack.Finalize.Insert(l, retryMiddlewareID, middleware.After)
+	}
+
+	return stack.Finalize.Add(l, middleware.Before)
+}
+
+func (*serviceRateLimiter) ID() string {
+	return "TerraformServiceRateLimiter"
+}
+
+func (l *serviceRateLimiter) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	if err := l.wait(ctx); err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+	}
+	defer l.done()
+
+	return next.HandleFinalize(ctx, in)
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestServiceRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	l := newServiceRateLimiter(ServiceRateLimit{RequestsPerSecond: 2, Burst: 2})
+	l.now = func() time.Time { return now }
+	l.last = now
+
+	for i, want := range []time.Duration{0, 0, 500 * time.Millisecond, time.Second} {
+		if got := l.reserve(); got != want {
+			t.Errorf("reservation %d: got %s, want %s", i, got, want)
+		}
+	}
+
+	// The bucket refills at the sustained rate, up to the burst size.
+	now = now.Add(10 * time.Second)
+	for i, want := range []time.Duration{0, 0, 500 * time.Millisecond} {
+		if got := l.reserve(); got != want {
+			t.Errorf("reservation %d after refill: got %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestServiceRateLimiterReserveUnlimited(t *testing.T) {
+	t.Parallel()
+
+	l := newServiceRateLimiter(ServiceRateLimit{MaxInFlight: 1})
+
+	for i := range 100 {
+		if got := l.reserve(); got != 0 {
+			t.Fatalf("reservation %d: got %s, want 0", i, got)
+		}
+	}
+}
+
+func TestServiceRateLimiterMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxInFlight = 3
+		requests    = 20
+	)
+	l := newServiceRateLimiter(ServiceRateLimit{MaxInFlight: maxInFlight})
+
+	var current, peak atomic.Int32
+	handler := middleware.FinalizeHandlerFunc(func(context.Context, middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		n := current.Add(1)
+		for {
+			if p := peak.Load(); n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		current.Add(-1)
+
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, nil
+	})
+
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, _, err := l.HandleFinalize(t.Context(), middleware.FinalizeInput{}, handler); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > maxInFlight {
+		t.Errorf("peak in-flight requests: got %d, want at most %d", got, maxInFlight)
+	}
+}
+
+func TestServiceRateLimiterWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	l := newServiceRateLimiter(ServiceRateLimit{RequestsPerSecond: 0.001, MaxInFlight: 1})
+
+	if err := l.wait(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	l.done()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The concurrency slot is released when waiting is canceled.
+	if got := len(l.inFlight); got != 0 {
+		t.Errorf("in-flight requests: got %d, want 0", got)
+	}
+}
+
+func TestServiceRateLimiterAddToStack(t *testing.T) {
+	t.Parallel()
+
+	l := newServiceRateLimiter(ServiceRateLimit{RequestsPerSecond: 1})
+	stack := middleware.NewStack("test", nil)
+
+	noop := func(id string) middleware.FinalizeMiddleware {
+		return middleware.FinalizeMiddlewareFunc(id, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			return next.HandleFinalize(ctx, in)
+		})
+	}
+	if err := stack.Finalize.Add(noop("Retry"), middleware.After); err != nil {
+		t.Fatal(err)
+	}
+	if err := stack.Finalize.Add(noop("Signing"), middleware.After); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := l.addToStack(stack); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := stack.Finalize.List()
+	want := []string{"Retry", l.ID(), "Signing"}
+	if len(got) != len(want) {
+		t.Fatalf("got middleware %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got middleware %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// PTRRecordName returns the reverse DNS (in-addr.arpa or ip6.arpa) name for an IP address.
+//
+// Ref:
+// - https://datatracker.ietf.org/doc/html/rfc1035#section-3.5.
+// - https://datatracker.ietf.org/doc/html/rfc3596#section-2.5.
+func PTRRecordName(ip string) (string, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid IP address: %w", ip, err)
+	}
+
+	addr = addr.Unmap()
+
+	var labels []string
+	var suffix string
+
+	if addr.Is4() {
+		for _, b := range addr.AsSlice() {
+			labels = append(labels, strconv.Itoa(int(b)))
+		}
+		suffix = "in-addr.arpa"
+	} else {
+		for _, b := range addr.AsSlice() {
+			labels = append(labels, strconv.FormatUint(uint64(b>>4), 16), strconv.FormatUint(uint64(b&0x0f), 16))
+		}
+		suffix = "ip6.arpa"
+	}
+
+	slices.Reverse(labels)
+
+	return strings.Join(labels, ".") + "." + suffix, nil
+}
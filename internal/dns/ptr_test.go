@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"testing"
+)
+
+func TestPTRRecordName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError bool
+	}{
+		{
+			name:          "empty",
+			input:         "",
+			expectedError: true,
+		},
+		{
+			name:          "invalid",
+			input:         "10.0.0",
+			expectedError: true,
+		},
+		{
+			name:     "IPv4",
+			input:    "192.0.2.10",
+			expected: "10.2.0.192.in-addr.arpa",
+		},
+		{
+			name:     "IPv4-mapped IPv6",
+			input:    "::ffff:192.0.2.10",
+			expected: "10.2.0.192.in-addr.arpa",
+		},
+		{
+			name:     "IPv6",
+			input:    "2001:db8::567:89ab",
+			expected: "b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := PTRRecordName(testCase.input)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("error: %v, expected error: %t", err, want)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
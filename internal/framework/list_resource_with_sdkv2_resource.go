@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -85,6 +86,19 @@ func (l *ListResourceWithSDKv2Resource) ResourceData() *schema.ResourceData {
 	return l.resourceSchema.Data(&terraform.InstanceState{})
 }
 
+// ReadResource populates the resource data by calling the resource's Read handler.
+// The resource data's ID is cleared if the resource no longer exists.
+func (l *ListResourceWithSDKv2Resource) ReadResource(ctx context.Context, client *conns.AWSClient, d *schema.ResourceData) error {
+	switch {
+	case l.resourceSchema.ReadWithoutTimeout != nil:
+		return sdkdiag.DiagnosticsError(l.resourceSchema.ReadWithoutTimeout(ctx, d, client))
+	case l.resourceSchema.ReadContext != nil:
+		return sdkdiag.DiagnosticsError(l.resourceSchema.ReadContext(ctx, d, client))
+	default:
+		return nil
+	}
+}
+
 func (l *ListResourceWithSDKv2Resource) setResourceIdentity(ctx context.Context, client *conns.AWSClient, d *schema.ResourceData) error {
 	identity, err := d.Identity()
 	if err != nil {
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type WithAssumeRoleARNModel struct {
+	AssumeRoleARN types.String `tfsdk:"assume_role_arn"`
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	arnSections = 6
+)
+
+var _ function.Function = arnMatchesFunction{}
+
+func NewARNMatchesFunction() function.Function {
+	return &arnMatchesFunction{}
+}
+
+type arnMatchesFunction struct{}
+
+func (f arnMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_matches"
+}
+
+func (f arnMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_matches Function",
+		MarkdownDescription: "Checks whether an ARN matches an ARN pattern using the semantics of the IAM `ArnLike` " +
+			"condition operator and policy `Resource` elements",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, which may contain `*` and `?` wildcards",
+			},
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to match",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := arn.Parse(arg); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	result, err := arnMatches(pattern, arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// arnMatches reports whether the ARN matches the pattern.
+// Each of the six colon-delimited sections of the ARN is matched separately,
+// so wildcards never match across the colons separating sections. Colons within
+// the final resource section are matched like any other character.
+// A pattern consisting of a single `*` matches any ARN.
+//
+// Ref: https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html#Conditions_ARN.
+func arnMatches(pattern, s string) (bool, error) {
+	if pattern == "*" {
+		return true, nil
+	}
+
+	patternSections := strings.SplitN(pattern, ":", arnSections)
+	if len(patternSections) != arnSections {
+		return false, fmt.Errorf("pattern %q must be \"*\" or have %d colon-separated sections", pattern, arnSections)
+	}
+
+	sections := strings.SplitN(s, ":", arnSections)
+	if len(sections) != arnSections {
+		return false, nil
+	}
+
+	for i, p := range patternSections {
+		if !wildcardMatch(p, sections[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// wildcardMatch reports whether s matches the pattern, in which `*` matches any
+// sequence of characters (including the empty sequence) and `?` matches any
+// single character. Matching is case-sensitive.
+func wildcardMatch(pattern, s string) bool {
+	p, r := []rune(pattern), []rune(s)
+	pi, ri := 0, 0
+	star, starRI := -1, 0
+
+	for ri < len(r) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == r[ri]):
+			pi++
+			ri++
+		case pi < len(p) && p[pi] == '*':
+			star, starRI = pi, ri
+			pi++
+		case star >= 0:
+			// Backtrack: let the last `*` consume one more character.
+			pi = star + 1
+			starRI++
+			ri = starRI
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
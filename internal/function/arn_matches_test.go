@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNMatchesFunction_matches(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchesFunctionConfig("*", "arn:aws:iam::444455556666:role/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testARNMatchesFunctionConfig("arn:*:iam::*:role/*", "arn:aws-us-gov:iam::444455556666:role/path/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:s3:::example-bucket-??/*", "arn:aws:s3:::example-bucket-01/logs/2025/01/01.log"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:logs:*:*:log-group:example:*", "arn:aws:logs:us-west-2:444455556666:log-group:example:log-stream:one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Wildcards do not match across section separators.
+				Config: testARNMatchesFunctionConfig("arn:aws:sqs:*:*:example", "arn:aws:sqs:us-east-1:444455556666:other:example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:iam::*:role/*", "arn:aws:iam::444455556666:user/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:s3:::example-bucket-?", "arn:aws:s3:::example-bucket-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:s3:::Example", "arn:aws:s3:::example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:sqs:us-east-1:*:*", "arn:aws:sqs:us-west-2:444455556666:example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNMatchesFunctionConfig("*", "invalid"),
+				ExpectError: regexache.MustCompile("arn: invalid prefix"),
+			},
+			{
+				Config:      testARNMatchesFunctionConfig("arn:aws:*:role/example", "arn:aws:iam::444455556666:role/example"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*"\*"[\s\n]*or[\s\n]*have[\s\n]*6[\s\n]*colon-separated[\s\n]*sections`),
+			},
+		},
+	})
+}
+
+func testARNMatchesFunctionConfig(pattern, arn string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::arn_matches(%[1]q, %[2]q)
+}
+`, pattern, arn)
+}
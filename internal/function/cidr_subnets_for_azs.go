@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// VPC subnet size limits reference:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+
+	subnetIPv4MinPrefixLength = 16
+	subnetIPv4MaxPrefixLength = 28
+	subnetIPv6MinPrefixLength = 44
+	subnetIPv6MaxPrefixLength = 64
+)
+
+var _ function.Function = cidrSubnetsForAZsFunction{}
+
+func NewCIDRSubnetsForAZsFunction() function.Function {
+	return &cidrSubnetsForAZsFunction{}
+}
+
+type cidrSubnetsForAZsFunction struct{}
+
+func (f cidrSubnetsForAZsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_for_azs"
+}
+
+func (f cidrSubnetsForAZsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_for_azs Function",
+		MarkdownDescription: "Allocates non-overlapping subnet CIDR blocks for each tier in each Availability Zone " +
+			"from a VPC CIDR block. Returns a map of tier name to a map of Availability Zone name to CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr",
+				MarkdownDescription: "VPC CIDR block to allocate subnets from",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zone names",
+				ElementType:         types.StringType,
+			},
+			function.MapParameter{
+				Name:                "tiers",
+				MarkdownDescription: "Map of tier name to subnet prefix length",
+				ElementType:         types.Int64Type,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.MapType{
+				ElemType: types.StringType,
+			},
+		},
+	}
+}
+
+func (f cidrSubnetsForAZsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDR string
+	var azs []string
+	var tiers map[string]int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDR, &azs, &tiers))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := planSubnetCIDRBlocks(vpcCIDR, azs, tiers)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// planSubnetCIDRBlocks allocates one subnet per tier per Availability Zone.
+// Tiers are allocated largest first (then by name) and Availability Zones in
+// the order specified, so that the result is deterministic and every block is
+// naturally aligned without gaps between allocations.
+func planSubnetCIDRBlocks(vpcCIDR string, azs []string, tiers map[string]int64) (map[string]map[string]string, error) {
+	if err := inttypes.ValidateCIDRBlock(vpcCIDR); err != nil {
+		return nil, err
+	}
+
+	vpc, err := netip.ParsePrefix(vpcCIDR)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(azs) == 0 {
+		return nil, errors.New("at least one Availability Zone must be specified")
+	}
+	seen := make(map[string]struct{}, len(azs))
+	for _, az := range azs {
+		if _, ok := seen[az]; ok {
+			return nil, fmt.Errorf("duplicate Availability Zone %q", az)
+		}
+		seen[az] = struct{}{}
+	}
+
+	minBits, maxBits := subnetIPv4MinPrefixLength, subnetIPv4MaxPrefixLength
+	if vpc.Addr().Is6() {
+		minBits, maxBits = subnetIPv6MinPrefixLength, subnetIPv6MaxPrefixLength
+	}
+
+	for tier, bits := range tiers {
+		if bits < int64(max(minBits, vpc.Bits())) || bits > int64(maxBits) {
+			return nil, fmt.Errorf("tier %q prefix length (%d) must be between %d and %d", tier, bits, max(minBits, vpc.Bits()), maxBits)
+		}
+	}
+
+	names := slices.SortedFunc(maps.Keys(tiers), func(a, b string) int {
+		return cmp.Or(cmp.Compare(tiers[a], tiers[b]), cmp.Compare(a, b))
+	})
+
+	result := make(map[string]map[string]string, len(tiers))
+	next, exhausted := vpc.Masked().Addr(), false
+
+	for _, tier := range names {
+		result[tier] = make(map[string]string, len(azs))
+
+		for _, az := range azs {
+			subnet := netip.PrefixFrom(next, int(tiers[tier]))
+
+			if exhausted || !vpc.Contains(next) || subnet.Masked() != subnet {
+				return nil, fmt.Errorf("VPC CIDR block %s exhausted allocating tier %q in Availability Zone %q", vpcCIDR, tier, az)
+			}
+
+			result[tier][az] = subnet.String()
+
+			next = lastAddr(subnet).Next()
+			exhausted = !next.IsValid()
+		}
+	}
+
+	return result, nil
+}
+
+// lastAddr returns the last address in the specified prefix.
+func lastAddr(p netip.Prefix) netip.Addr {
+	p = p.Masked()
+	b := p.Addr().AsSlice()
+
+	for i := p.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 1 << (7 - i%8)
+	}
+
+	addr, _ := netip.AddrFromSlice(b)
+
+	return addr
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsForAZsFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/16", `{ public = 24, private = 20, database = 26 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("private_a", "10.0.0.0/20"),
+					resource.TestCheckOutput("private_b", "10.0.16.0/20"),
+					resource.TestCheckOutput("public_a", "10.0.32.0/24"),
+					resource.TestCheckOutput("public_b", "10.0.33.0/24"),
+					resource.TestCheckOutput("database_a", "10.0.34.0/26"),
+					resource.TestCheckOutput("database_b", "10.0.34.64/26"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsForAZsFunctionConfig("2600:1f18::/56", `{ public = 64, private = 64, database = 64 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("database_a", "2600:1f18::/64"),
+					resource.TestCheckOutput("database_b", "2600:1f18:0:1::/64"),
+					resource.TestCheckOutput("private_a", "2600:1f18:0:2::/64"),
+					resource.TestCheckOutput("private_b", "2600:1f18:0:3::/64"),
+					resource.TestCheckOutput("public_a", "2600:1f18:0:4::/64"),
+					resource.TestCheckOutput("public_b", "2600:1f18:0:5::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_exhausted(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/24", `{ public = 25, private = 25, database = 26 }`),
+				ExpectError: regexache.MustCompile(`exhausted`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.1/16", `{ public = 24, private = 24, database = 24 }`),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/16", `{ public = 24, private = 24, database = 29 }`),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsForAZsFunctionConfig(vpcCIDR, tiers string) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::cidr_subnets_for_azs(%[1]q, ["us-west-2a", "us-west-2b"], %[2]s)
+}
+
+output "public_a" {
+  value = local.subnets["public"]["us-west-2a"]
+}
+
+output "public_b" {
+  value = local.subnets["public"]["us-west-2b"]
+}
+
+output "private_a" {
+  value = local.subnets["private"]["us-west-2a"]
+}
+
+output "private_b" {
+  value = local.subnets["private"]["us-west-2b"]
+}
+
+output "database_a" {
+  value = local.subnets["database"]["us-west-2a"]
+}
+
+output "database_b" {
+  value = local.subnets["database"]["us-west-2b"]
+}
+`, vpcCIDR, tiers)
+}
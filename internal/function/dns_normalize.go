@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+)
+
+var _ function.Function = dnsNormalizeFunction{}
+
+func NewDNSNormalizeFunction() function.Function {
+	return &dnsNormalizeFunction{}
+}
+
+type dnsNormalizeFunction struct{}
+
+func (f dnsNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dns_normalize"
+}
+
+func (f dnsNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "dns_normalize Function",
+		MarkdownDescription: "Normalizes a domain name to the format returned by the Route 53 API. The trailing " +
+			"period is removed, letters are converted to lower case and special characters are converted to octal escape codes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Domain name to normalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f dnsNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, dns.Normalize(arg)))
+}
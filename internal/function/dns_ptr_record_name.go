@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+)
+
+var _ function.Function = dnsPTRRecordNameFunction{}
+
+func NewDNSPTRRecordNameFunction() function.Function {
+	return &dnsPTRRecordNameFunction{}
+}
+
+type dnsPTRRecordNameFunction struct{}
+
+func (f dnsPTRRecordNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dns_ptr_record_name"
+}
+
+func (f dnsPTRRecordNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "dns_ptr_record_name Function",
+		MarkdownDescription: "Builds the reverse DNS (`in-addr.arpa` or `ip6.arpa`) PTR record name for an IP address",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ip_address",
+				MarkdownDescription: "IPv4 or IPv6 address",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f dnsPTRRecordNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := dns.PTRRecordName(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
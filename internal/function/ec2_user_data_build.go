@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// EC2 user data is limited to 16 KB in raw form, before it is base64 encoded.
+	// See https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/user-data.html.
+	ec2UserDataMaxSize = 16384
+
+	// ec2UserDataBoundary is the fixed MIME boundary used when building multipart user data,
+	// so that the same parts always produce the same user data.
+	ec2UserDataBoundary = "MIMEBOUNDARY"
+
+	ec2UserDataDefaultContentType = "text/plain"
+)
+
+var _ function.Function = ec2UserDataBuildFunction{}
+
+func NewEC2UserDataBuildFunction() function.Function {
+	return &ec2UserDataBuildFunction{}
+}
+
+type ec2UserDataBuildFunction struct{}
+
+func (f ec2UserDataBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ec2_user_data_build"
+}
+
+func (f ec2UserDataBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ec2_user_data_build Function",
+		MarkdownDescription: "Builds a MIME multipart cloud-init archive suitable for EC2 user data, optionally gzip compressed. " +
+			"Returns the base64 encoded archive, or an error if it exceeds the 16 KB EC2 user data limit.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name: "parts",
+				MarkdownDescription: "List of parts. Each part is a map with a required `content` key and optional " +
+					"`content_type`, `filename` and `merge_type` keys",
+				ElementType: types.MapType{
+					ElemType: types.StringType,
+				},
+			},
+			function.BoolParameter{
+				Name:                "gzip",
+				MarkdownDescription: "Whether to gzip compress the archive",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ec2UserDataBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parts []map[string]string
+	var compress bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &parts, &compress))
+	if resp.Error != nil {
+		return
+	}
+
+	b, err := buildEC2UserData(parts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if compress {
+		if b, err = gzipEC2UserData(b); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+			return
+		}
+	}
+
+	if n := len(b); n > ec2UserDataMaxSize {
+		msg := fmt.Sprintf("user data is %d bytes, which exceeds the EC2 user data limit of %d bytes", n, ec2UserDataMaxSize)
+		if !compress {
+			msg += "; consider enabling gzip compression"
+		}
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(msg))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, inttypes.Base64Encode(b)))
+}
+
+// buildEC2UserData builds a MIME multipart archive in the format understood by cloud-init.
+// Part headers are written in a deterministic order.
+func buildEC2UserData(parts []map[string]string) ([]byte, error) {
+	if len(parts) == 0 {
+		return nil, errors.New("at least one part must be specified")
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n", ec2UserDataBoundary)
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n\r\n")
+
+	w := multipart.NewWriter(&buf)
+	if err := w.SetBoundary(ec2UserDataBoundary); err != nil {
+		return nil, err
+	}
+
+	for i, part := range parts {
+		for k := range part {
+			switch k {
+			case "content", "content_type", "filename", "merge_type":
+			default:
+				return nil, fmt.Errorf("part %d: unsupported key %q", i, k)
+			}
+		}
+
+		content, ok := part["content"]
+		if !ok {
+			return nil, fmt.Errorf("part %d: content is required", i)
+		}
+		if strings.Contains(content, "--"+ec2UserDataBoundary) {
+			return nil, fmt.Errorf("part %d: content must not contain the MIME boundary %q", i, ec2UserDataBoundary)
+		}
+
+		contentType := part["content_type"]
+		if contentType == "" {
+			contentType = ec2UserDataDefaultContentType
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Type", contentType)
+		header.Set("MIME-Version", "1.0")
+		if v := part["filename"]; v != "" {
+			header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": v}))
+		}
+		if v := part["merge_type"]; v != "" {
+			header.Set("X-Merge-Type", v)
+		}
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := pw.Write([]byte(content)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// gzipEC2UserData compresses user data. The gzip header is left empty so that the output is deterministic.
+func gzipEC2UserData(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
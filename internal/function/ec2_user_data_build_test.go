@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEC2UserDataBuildFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2UserDataBuildFunctionConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Content-Type: multipart/mixed; boundary=\"MIMEBOUNDARY\"\r\nMIME-Version: 1.0\r\n\r\n"+
+						"--MIMEBOUNDARY\r\nContent-Disposition: attachment; filename=cloud.cfg\r\nContent-Type: text/cloud-config\r\nMime-Version: 1.0\r\n\r\n"+
+						"#cloud-config\npackages: [jq]\n\r\n"+
+						"--MIMEBOUNDARY\r\nContent-Type: text/x-shellscript\r\nMime-Version: 1.0\r\n\r\n"+
+						"#!/bin/bash\necho hello\n\r\n"+
+						"--MIMEBOUNDARY--\r\n"),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2UserDataBuildFunction_gzip(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				// 20000 bytes of highly compressible content fits within the limit once compressed.
+				Config: testEC2UserDataBuildFunctionConfig_large(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2UserDataBuildFunction_tooLarge(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEC2UserDataBuildFunctionConfig_large(false),
+				ExpectError: regexache.MustCompile(`exceeds[\s\n]*the[\s\n]*EC2[\s\n]*user[\s\n]*data[\s\n]*limit[\s\n]*of[\s\n]*16384[\s\n]*bytes`),
+			},
+		},
+	})
+}
+
+func TestEC2UserDataBuildFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEC2UserDataBuildFunctionConfig_noContent,
+				ExpectError: regexache.MustCompile(`part[\s\n]*0:[\s\n]*content[\s\n]*is[\s\n]*required`),
+			},
+		},
+	})
+}
+
+const testEC2UserDataBuildFunctionConfig_basic = `
+output "test" {
+  value = base64decode(provider::aws::ec2_user_data_build([
+    {
+      content_type = "text/cloud-config"
+      filename     = "cloud.cfg"
+      content      = "#cloud-config\npackages: [jq]\n"
+    },
+    {
+      content_type = "text/x-shellscript"
+      content      = "#!/bin/bash\necho hello\n"
+    },
+  ], false))
+}
+`
+
+func testEC2UserDataBuildFunctionConfig_large(gzip bool) string {
+	return fmt.Sprintf(`
+locals {
+  user_data = provider::aws::ec2_user_data_build([
+    {
+      content_type = "text/x-shellscript"
+      content      = "#!/bin/bash\n${join("", [for i in range(2000) : "#########\n"])}"
+    },
+  ], %[1]t)
+}
+
+output "test" {
+  value = provider::aws::ec2_user_data_decode(local.user_data).size < 16384
+}
+`, gzip)
+}
+
+const testEC2UserDataBuildFunctionConfig_noContent = `
+output "test" {
+  value = provider::aws::ec2_user_data_build([
+    {
+      content_type = "text/cloud-config"
+    },
+  ], false)
+}
+`
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// cloud-init treats user data as MIME if this header appears in the first 4096 bytes.
+	ec2UserDataMIMESniffLength = 4096
+
+	// ec2UserDataNotMultipartContentType is the content type cloud-init assigns to
+	// user data that is not a MIME archive and has no recognized prefix.
+	ec2UserDataNotMultipartContentType = "text/x-not-multipart"
+)
+
+var (
+	ec2UserDataPartAttrTypes = map[string]attr.Type{
+		"content":      types.StringType,
+		"content_type": types.StringType,
+		"filename":     types.StringType,
+		"merge_type":   types.StringType,
+	}
+	ec2UserDataDecodeResultAttrTypes = map[string]attr.Type{
+		"gzip":  types.BoolType,
+		"parts": types.ListType{ElemType: types.ObjectType{AttrTypes: ec2UserDataPartAttrTypes}},
+		"size":  types.Int64Type,
+	}
+
+	// ec2UserDataContentTypePrefixes maps the first line prefixes recognized by
+	// cloud-init to content types. Longer prefixes must precede their own prefixes.
+	ec2UserDataContentTypePrefixes = []struct {
+		prefix      string
+		contentType string
+	}{
+		{"#include-once", "text/x-include-once-url"},
+		{"#include", "text/x-include-url"},
+		{"#!", "text/x-shellscript"},
+		{"#cloud-config-archive", "text/cloud-config-archive"},
+		{"#cloud-config-jsonp", "text/cloud-config-jsonp"},
+		{"#cloud-config", "text/cloud-config"},
+		{"#cloud-boothook", "text/cloud-boothook"},
+		{"#part-handler", "text/part-handler"},
+		{"## template: jinja", "text/jinja2"},
+	}
+)
+
+var _ function.Function = ec2UserDataDecodeFunction{}
+
+func NewEC2UserDataDecodeFunction() function.Function {
+	return &ec2UserDataDecodeFunction{}
+}
+
+type ec2UserDataDecodeFunction struct{}
+
+func (f ec2UserDataDecodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ec2_user_data_decode"
+}
+
+func (f ec2UserDataDecodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ec2_user_data_decode Function",
+		MarkdownDescription: "Decodes EC2 user data, which may be base64 encoded, gzip compressed and a MIME multipart cloud-init archive. " +
+			"Returns an object with the raw size in bytes, whether the user data is gzip compressed and its parts.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "user_data",
+				MarkdownDescription: "User data, optionally base64 encoded",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: ec2UserDataDecodeResultAttrTypes,
+		},
+	}
+}
+
+func (f ec2UserDataDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	// As for the aws_instance resource's user_data argument, values that are valid base64 are treated as base64 encoded.
+	b, err := inttypes.Base64Decode(arg)
+	if err != nil {
+		b = []byte(arg)
+	}
+	size := len(b)
+
+	compressed := bytes.HasPrefix(b, []byte{0x1f, 0x8b})
+	if compressed {
+		if b, err = gunzipEC2UserData(b); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+			return
+		}
+	}
+
+	parts, err := decodeEC2UserData(b)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	elems := make([]attr.Value, 0, len(parts))
+	for _, part := range parts {
+		elem, d := types.ObjectValue(ec2UserDataPartAttrTypes, map[string]attr.Value{
+			"content":      types.StringValue(part["content"]),
+			"content_type": types.StringValue(part["content_type"]),
+			"filename":     types.StringValue(part["filename"]),
+			"merge_type":   types.StringValue(part["merge_type"]),
+		})
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+		elems = append(elems, elem)
+	}
+
+	list, d := types.ListValue(types.ObjectType{AttrTypes: ec2UserDataPartAttrTypes}, elems)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	result, d := types.ObjectValue(ec2UserDataDecodeResultAttrTypes, map[string]attr.Value{
+		"gzip":  types.BoolValue(compressed),
+		"parts": list,
+		"size":  types.Int64Value(int64(size)),
+	})
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func gunzipEC2UserData(b []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing user data: %w", err)
+	}
+	defer r.Close()
+
+	b, err = io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing user data: %w", err)
+	}
+
+	return b, nil
+}
+
+// decodeEC2UserData splits user data into parts the way cloud-init does.
+// User data that is not a MIME archive is returned as a single part whose
+// content type is determined from its first line.
+func decodeEC2UserData(b []byte) ([]map[string]string, error) {
+	if !bytes.Contains(bytes.ToLower(b[:min(len(b), ec2UserDataMIMESniffLength)]), []byte("mime-version:")) {
+		return []map[string]string{{
+			"content":      string(b),
+			"content_type": ec2UserDataContentType(string(b)),
+		}}, nil
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("reading MIME message: %w", err)
+	}
+	header := textproto.MIMEHeader(msg.Header)
+
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing MIME Content-Type: %w", err)
+	}
+
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		body, err := io.ReadAll(msg.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading MIME message: %w", err)
+		}
+		content, err := decodeEC2UserDataContent(header, body)
+		if err != nil {
+			return nil, err
+		}
+		return []map[string]string{ec2UserDataPart(header, mediaType, content)}, nil
+	}
+
+	boundary := params["boundary"]
+	if boundary == "" {
+		return nil, fmt.Errorf("%s Content-Type has no boundary", mediaType)
+	}
+
+	r := multipart.NewReader(msg.Body, boundary)
+	var parts []map[string]string
+
+	for {
+		p, err := r.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading MIME part %d: %w", len(parts), err)
+		}
+
+		body, err := io.ReadAll(p)
+		if err != nil {
+			return nil, fmt.Errorf("reading MIME part %d: %w", len(parts), err)
+		}
+
+		content, err := decodeEC2UserDataContent(p.Header, body)
+		if err != nil {
+			return nil, fmt.Errorf("reading MIME part %d: %w", len(parts), err)
+		}
+
+		mediaType := ec2UserDataDefaultContentType
+		if v := p.Header.Get("Content-Type"); v != "" {
+			if mediaType, _, err = mime.ParseMediaType(v); err != nil {
+				return nil, fmt.Errorf("reading MIME part %d: parsing Content-Type: %w", len(parts), err)
+			}
+		}
+
+		parts = append(parts, ec2UserDataPart(p.Header, mediaType, content))
+	}
+
+	return parts, nil
+}
+
+// decodeEC2UserDataContent decodes base64 Content-Transfer-Encoding.
+// Quoted-printable content is decoded by the multipart reader.
+func decodeEC2UserDataContent(header textproto.MIMEHeader, body []byte) (string, error) {
+	if !strings.EqualFold(header.Get("Content-Transfer-Encoding"), "base64") {
+		return string(body), nil
+	}
+
+	b, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, bytes.NewReader(bytes.Join(bytes.Fields(body), nil))))
+	if err != nil {
+		return "", fmt.Errorf("decoding base64 content: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func ec2UserDataPart(header textproto.MIMEHeader, mediaType, content string) map[string]string {
+	part := map[string]string{
+		"content":      content,
+		"content_type": mediaType,
+		"merge_type":   header.Get("X-Merge-Type"),
+	}
+
+	if _, params, err := mime.ParseMediaType(header.Get("Content-Disposition")); err == nil {
+		part["filename"] = params["filename"]
+	}
+
+	return part
+}
+
+// ec2UserDataContentType returns the content type cloud-init assigns to non-MIME user data.
+func ec2UserDataContentType(s string) string {
+	for _, v := range ec2UserDataContentTypePrefixes {
+		if strings.HasPrefix(s, v.prefix) {
+			return v.contentType
+		}
+	}
+
+	return ec2UserDataNotMultipartContentType
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEC2UserDataDecodeFunction_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2UserDataDecodeFunctionConfig_roundTrip(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("gzip", acctest.CtFalse),
+					resource.TestCheckOutput("parts", `[{"content":"#cloud-config\npackages: [jq]\n","content_type":"text/cloud-config","filename":"cloud.cfg","merge_type":"list(append)+dict(recurse_array)"},{"content":"#!/bin/bash\necho hello\n","content_type":"text/x-shellscript","filename":"","merge_type":""}]`),
+				),
+			},
+			{
+				Config: testEC2UserDataDecodeFunctionConfig_roundTrip(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("gzip", acctest.CtTrue),
+					resource.TestCheckOutput("parts", `[{"content":"#cloud-config\npackages: [jq]\n","content_type":"text/cloud-config","filename":"cloud.cfg","merge_type":"list(append)+dict(recurse_array)"},{"content":"#!/bin/bash\necho hello\n","content_type":"text/x-shellscript","filename":"","merge_type":""}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2UserDataDecodeFunction_notMultipart(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2UserDataDecodeFunctionConfig_plain(`#cloud-config\npackages: [jq]\n`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("content_type", "text/cloud-config"),
+					resource.TestCheckOutput("size", "28"),
+				),
+			},
+			{
+				Config: testEC2UserDataDecodeFunctionConfig_plain(`echo hello`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("content_type", "text/x-not-multipart"),
+					resource.TestCheckOutput("size", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2UserDataDecodeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEC2UserDataDecodeFunctionConfig_plain(`MIME-Version: 1.0\nContent-Type: multipart/mixed\n\n`),
+				ExpectError: regexache.MustCompile(`multipart/mixed[\s\n]*Content-Type[\s\n]*has[\s\n]*no[\s\n]*boundary`),
+			},
+		},
+	})
+}
+
+func testEC2UserDataDecodeFunctionConfig_roundTrip(gzip bool) string {
+	return fmt.Sprintf(`
+locals {
+  user_data = provider::aws::ec2_user_data_build([
+    {
+      content_type = "text/cloud-config"
+      filename     = "cloud.cfg"
+      merge_type   = "list(append)+dict(recurse_array)"
+      content      = "#cloud-config\npackages: [jq]\n"
+    },
+    {
+      content_type = "text/x-shellscript"
+      content      = "#!/bin/bash\necho hello\n"
+    },
+  ], %[1]t)
+
+  decoded = provider::aws::ec2_user_data_decode(local.user_data)
+}
+
+output "gzip" {
+  value = local.decoded.gzip
+}
+
+output "parts" {
+  value = jsonencode(local.decoded.parts)
+}
+`, gzip)
+}
+
+func testEC2UserDataDecodeFunctionConfig_plain(userData string) string {
+	return fmt.Sprintf(`
+locals {
+  decoded = provider::aws::ec2_user_data_decode("%[1]s")
+}
+
+output "content_type" {
+  value = local.decoded.parts[0].content_type
+}
+
+output "size" {
+  value = local.decoded.size
+}
+`, userData)
+}
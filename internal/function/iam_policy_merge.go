@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents into a single normalized policy document. Statements " +
+			"with the same non-empty Sid in later documents replace those in earlier documents.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				MarkdownDescription: "IAM policy documents in JSON format",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergeIAMPolicyDocuments(args)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// mergeIAMPolicyDocuments merges the specified policy documents in order.
+// This mirrors the override semantics of the aws_iam_policy_document data
+// source's override_policy_documents argument.
+func mergeIAMPolicyDocuments(policies []string) (string, error) {
+	merged := &iamPolicyDocument{}
+
+	for i, policy := range policies {
+		doc, err := parseIAMPolicyDocument(policy)
+		if err != nil {
+			return "", fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		if doc.Version != "" {
+			merged.Version = doc.Version
+		}
+		if doc.ID != "" {
+			merged.ID = doc.ID
+		}
+
+	statements:
+		for _, statement := range doc.Statement {
+			if sid := statement.sid(); sid != "" {
+				for j, existing := range merged.Statement {
+					if existing.sid() == sid {
+						merged.Statement[j] = statement
+						continue statements
+					}
+				}
+			}
+
+			merged.Statement = append(merged.Statement, statement)
+		}
+	}
+
+	merged.Statement = compactIAMPolicyStatements(merged.Statement)
+	merged.sortStatements()
+
+	return merged.String()
+}
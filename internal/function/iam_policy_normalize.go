@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy document into a canonical JSON form. Statements are " +
+			"sorted, equivalent statements are removed, single-valued elements are collapsed, and AWS account " +
+			"principals are written as account IDs so that equivalent policies produce identical output.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	doc, err := parseIAMPolicyDocument(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := doc.String()
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// iamPolicyDocument is the canonical representation of an IAM policy document.
+// Field order matches the order AWS expects, with Version first.
+type iamPolicyDocument struct {
+	Version   string               `json:",omitempty"`
+	ID        string               `json:"Id,omitempty"`
+	Statement []iamPolicyStatement `json:",omitempty"`
+}
+
+// iamPolicyStatement is a single normalized policy statement. Keys are
+// marshaled in lexical order, which keeps the output deterministic.
+type iamPolicyStatement map[string]any
+
+// String returns the canonical JSON representation of the policy document.
+func (d *iamPolicyDocument) String() (string, error) {
+	if d.Version == "" && d.ID == "" && len(d.Statement) == 0 {
+		return "{}", nil
+	}
+
+	return marshalIAMPolicyJSON(d)
+}
+
+// sortStatements orders statements by Sid, then by their canonical JSON.
+func (d *iamPolicyDocument) sortStatements() {
+	slices.SortStableFunc(d.Statement, func(a, b iamPolicyStatement) int {
+		return cmp.Or(
+			cmp.Compare(a.sid(), b.sid()),
+			cmp.Compare(a.key(), b.key()),
+		)
+	})
+}
+
+func (s iamPolicyStatement) sid() string {
+	v, _ := s["Sid"].(string)
+	return v
+}
+
+// key returns the canonical JSON of the statement, used for ordering.
+func (s iamPolicyStatement) key() string {
+	v, _ := marshalIAMPolicyJSON(s)
+	return v
+}
+
+// equivalent returns whether the statement is equivalent to another statement,
+// using the same policy equivalence rules that suppress diffs in policy arguments.
+func (s iamPolicyStatement) equivalent(other iamPolicyStatement) bool {
+	if s.key() == other.key() {
+		return true
+	}
+
+	v1, err := (&iamPolicyDocument{Statement: []iamPolicyStatement{s}}).String()
+	if err != nil {
+		return false
+	}
+
+	v2, err := (&iamPolicyDocument{Statement: []iamPolicyStatement{other}}).String()
+	if err != nil {
+		return false
+	}
+
+	return verify.PolicyStringsEquivalent(v1, v2)
+}
+
+// marshalIAMPolicyJSON returns the compact JSON encoding of v.
+// HTML characters are not escaped as they commonly appear in condition values.
+func marshalIAMPolicyJSON(v any) (string, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// parseIAMPolicyDocument parses and normalizes an IAM policy document.
+func parseIAMPolicyDocument(s string) (*iamPolicyDocument, error) {
+	var raw map[string]any
+
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	doc := &iamPolicyDocument{}
+
+	for k, v := range raw {
+		switch k {
+		case "Version":
+			version, ok := v.(string)
+			if !ok {
+				return nil, errors.New("policy Version must be a string")
+			}
+			doc.Version = version
+		case "Id":
+			id, ok := v.(string)
+			if !ok {
+				return nil, errors.New("policy Id must be a string")
+			}
+			doc.ID = id
+		case "Statement":
+			var statements []any
+			switch v := v.(type) {
+			case map[string]any:
+				statements = []any{v}
+			case []any:
+				statements = v
+			default:
+				return nil, errors.New("policy Statement must be an object or a list of objects")
+			}
+
+			for i, v := range statements {
+				m, ok := v.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("policy Statement %d must be an object", i)
+				}
+
+				statement, err := normalizeIAMPolicyStatement(m)
+				if err != nil {
+					return nil, fmt.Errorf("policy Statement %d: %w", i, err)
+				}
+
+				doc.Statement = append(doc.Statement, statement)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported policy element %q", k)
+		}
+	}
+
+	doc.Statement = compactIAMPolicyStatements(doc.Statement)
+	doc.sortStatements()
+
+	return doc, nil
+}
+
+// compactIAMPolicyStatements removes statements that are equivalent to an earlier statement.
+func compactIAMPolicyStatements(statements []iamPolicyStatement) []iamPolicyStatement {
+	var result []iamPolicyStatement
+
+	for _, statement := range statements {
+		if !slices.ContainsFunc(result, statement.equivalent) {
+			result = append(result, statement)
+		}
+	}
+
+	return result
+}
+
+func normalizeIAMPolicyStatement(m map[string]any) (iamPolicyStatement, error) {
+	statement := make(iamPolicyStatement, len(m))
+
+	for k, v := range m {
+		switch k {
+		case "Sid", "Effect":
+			if _, ok := v.(string); !ok {
+				return nil, fmt.Errorf("%s must be a string", k)
+			}
+			statement[k] = v
+		case "Action", "NotAction", "Resource", "NotResource":
+			v, err := normalizeIAMPolicyValues(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			statement[k] = v
+		case "Principal", "NotPrincipal":
+			v, err := normalizeIAMPolicyPrincipal(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			statement[k] = v
+		case "Condition":
+			v, err := normalizeIAMPolicyCondition(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			statement[k] = v
+		default:
+			return nil, fmt.Errorf("unsupported statement element %q", k)
+		}
+	}
+
+	return statement, nil
+}
+
+// normalizeIAMPolicyPrincipal normalizes a principal block. The wildcard
+// principal ("*") is retained as-is and {"AWS": "*"}, which IAM treats the same,
+// is collapsed to it. Otherwise each principal type's values are normalized.
+func normalizeIAMPolicyPrincipal(v any) (any, error) {
+	switch v := v.(type) {
+	case string:
+		if v != "*" {
+			return nil, errors.New(`string value must be "*"`)
+		}
+		return v, nil
+	case map[string]any:
+		principal := make(map[string]any, len(v))
+		for typ, values := range v {
+			if typ == "AWS" {
+				values = normalizeIAMPolicyAWSPrincipals(values)
+			}
+
+			values, err := normalizeIAMPolicyValues(values)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", typ, err)
+			}
+			principal[typ] = values
+		}
+
+		if len(principal) == 1 && principal["AWS"] == "*" {
+			return "*", nil
+		}
+
+		return principal, nil
+	default:
+		return nil, errors.New("must be a string or an object")
+	}
+}
+
+// normalizeIAMPolicyAWSPrincipals replaces account root principals
+// (arn:PARTITION:iam::ACCOUNTID:root) with the equivalent account ID.
+func normalizeIAMPolicyAWSPrincipals(v any) any {
+	normalize := func(v any) any {
+		s, ok := v.(string)
+		if !ok {
+			return v
+		}
+
+		principalARN, err := arn.Parse(s)
+		if err != nil {
+			return v
+		}
+
+		if principalARN.Service == "iam" && principalARN.Resource == "root" && inttypes.IsAWSAccountID(principalARN.AccountID) {
+			return principalARN.AccountID
+		}
+
+		return v
+	}
+
+	switch v := v.(type) {
+	case []any:
+		values := make([]any, len(v))
+		for i, v := range v {
+			values[i] = normalize(v)
+		}
+		return values
+	default:
+		return normalize(v)
+	}
+}
+
+func normalizeIAMPolicyCondition(v any) (any, error) {
+	operators, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("must be an object")
+	}
+
+	condition := make(map[string]any, len(operators))
+	for operator, v := range operators {
+		keys, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object", operator)
+		}
+
+		m := make(map[string]any, len(keys))
+		for key, values := range keys {
+			values, err := normalizeIAMPolicyValues(values)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", operator, key, err)
+			}
+			m[key] = values
+		}
+		condition[operator] = m
+	}
+
+	return condition, nil
+}
+
+// normalizeIAMPolicyValues normalizes an element that accepts either a single
+// value or a list of values. Lists are de-duplicated and sorted, and lists
+// containing exactly one value are collapsed to that value.
+func normalizeIAMPolicyValues(v any) (any, error) {
+	var values []any
+
+	switch v := v.(type) {
+	case []any:
+		values = v
+	case string, bool, float64:
+		return v, nil
+	default:
+		return nil, errors.New("must be a scalar or a list of scalars")
+	}
+
+	keyed := make(map[string]any, len(values))
+	for _, v := range values {
+		switch v.(type) {
+		case string, bool, float64:
+		default:
+			return nil, errors.New("list elements must be scalars")
+		}
+
+		k, err := marshalIAMPolicyJSON(v)
+		if err != nil {
+			return nil, err
+		}
+		keyed[k] = v
+	}
+
+	keys := make([]string, 0, len(keyed))
+	for k := range keyed {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	switch len(keys) {
+	case 0:
+		return []any{}, nil
+	case 1:
+		return keyed[keys[0]], nil
+	}
+
+	result := make([]any, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, keyed[k])
+	}
+
+	return result, nil
+}
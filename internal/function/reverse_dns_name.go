@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+)
+
+var _ function.Function = reverseDNSNameFunction{}
+
+func NewReverseDNSNameFunction() function.Function {
+	return &reverseDNSNameFunction{}
+}
+
+type reverseDNSNameFunction struct{}
+
+func (f reverseDNSNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "reverse_dns_name"
+}
+
+func (f reverseDNSNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "reverse_dns_name Function",
+		MarkdownDescription: "Reverses the order of the labels in a domain name, for example converting " +
+			"`amazonaws.com` to `com.amazonaws` and vice-versa.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Domain name to reverse",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f reverseDNSNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, dns.Reverse(arg)))
+}
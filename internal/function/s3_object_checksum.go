@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"crypto/sha1" // nosemgrep: go.lang.security.audit.crypto.use_of_weak_crypto.use-of-sha1
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+// crc64NVMETable is the table for the CRC-64/NVME polynomial, in reversed form.
+var crc64NVMETable = crc64.MakeTable(0x9a6c9329ac4bc9b5)
+
+var _ function.Function = s3ObjectChecksumFunction{}
+
+func NewS3ObjectChecksumFunction() function.Function {
+	return &s3ObjectChecksumFunction{}
+}
+
+type s3ObjectChecksumFunction struct{}
+
+func (f s3ObjectChecksumFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_object_checksum"
+}
+
+func (f s3ObjectChecksumFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "s3_object_checksum Function",
+		MarkdownDescription: "Computes the additional checksum that Amazon S3 returns for an object uploaded from a local file. " +
+			"A part size of `0` computes the full object checksum, otherwise the composite checksum of a multipart upload is computed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "path",
+				MarkdownDescription: "Path to the local file",
+			},
+			function.StringParameter{
+				Name:                "algorithm",
+				MarkdownDescription: "Checksum algorithm. One of `CRC32`, `CRC32C`, `CRC64NVME`, `SHA1` or `SHA256`",
+			},
+			function.Int64Parameter{
+				Name:                "part_size",
+				MarkdownDescription: "Multipart upload part size in bytes, or `0` for the full object checksum",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f s3ObjectChecksumFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path, algorithm string
+	var partSize int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &path, &algorithm, &partSize))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := s3ObjectChecksum(path, awstypes.ChecksumAlgorithm(algorithm), partSize)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// s3ObjectChecksum returns the base64-encoded checksum of the specified file.
+// Multipart uploads have a composite checksum, the checksum of the concatenated
+// part checksums suffixed with the number of parts, except for CRC-64/NVME which
+// only supports full object checksums.
+func s3ObjectChecksum(path string, algorithm awstypes.ChecksumAlgorithm, partSize int64) (string, error) {
+	var newHash func() hash.Hash
+
+	switch algorithm {
+	case awstypes.ChecksumAlgorithmCrc32:
+		newHash = func() hash.Hash { return crc32.NewIEEE() }
+	case awstypes.ChecksumAlgorithmCrc32c:
+		newHash = func() hash.Hash { return crc32.New(crc32.MakeTable(crc32.Castagnoli)) }
+	case awstypes.ChecksumAlgorithmCrc64nvme:
+		newHash = func() hash.Hash { return crc64.New(crc64NVMETable) }
+		partSize = 0
+	case awstypes.ChecksumAlgorithmSha1:
+		newHash = sha1.New
+	case awstypes.ChecksumAlgorithmSha256:
+		newHash = sha256.New
+	default:
+		return "", fmt.Errorf("algorithm must be one of %v", enum.Values[awstypes.ChecksumAlgorithm]())
+	}
+
+	sums, err := hashS3ObjectParts(path, partSize, newHash)
+	if err != nil {
+		return "", err
+	}
+
+	if len(sums) == 1 {
+		return base64.StdEncoding.EncodeToString(sums[0]), nil
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(compositeDigest(newHash, sums)), len(sums)), nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3ObjectChecksumFunction_fullObject(t *testing.T) {
+	t.Parallel()
+	path := testS3ObjectFunctionFile(t, []byte("hello world"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3ObjectChecksumFunctionConfig(path, "CRC32", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "DUoRhQ=="),
+				),
+			},
+			{
+				Config: testS3ObjectChecksumFunctionConfig(path, "CRC32C", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "yZRlqg=="),
+				),
+			},
+			{
+				Config: testS3ObjectChecksumFunctionConfig(path, "CRC64NVME", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "jSnVw/bqjr4="),
+				),
+			},
+			{
+				Config: testS3ObjectChecksumFunctionConfig(path, "SHA1", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Kq5sNclPz7QV2+lfQIuc6R7oRu0="),
+				),
+			},
+			{
+				Config: testS3ObjectChecksumFunctionConfig(path, "SHA256", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek="),
+				),
+			},
+		},
+	})
+}
+
+func TestS3ObjectChecksumFunction_composite(t *testing.T) {
+	t.Parallel()
+	path := testS3ObjectFunctionFile(t, testS3ObjectFunctionMultipartContent())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3ObjectChecksumFunctionConfig(path, "CRC32", 5242880),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "IBFDMg==-3"),
+				),
+			},
+			{
+				Config: testS3ObjectChecksumFunctionConfig(path, "SHA256", 5242880),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "p5uz3sUcKZu/MQo4s/RvN0oeDjqBFMUxJaPlXNlrXjU=-3"),
+				),
+			},
+			{
+				// CRC-64/NVME only supports full object checksums.
+				Config: testS3ObjectChecksumFunctionConfig(path, "CRC64NVME", 5242880),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "IbIy6cnIEzw="),
+				),
+			},
+		},
+	})
+}
+
+func TestS3ObjectChecksumFunction_invalidAlgorithm(t *testing.T) {
+	t.Parallel()
+	path := testS3ObjectFunctionFile(t, []byte("hello world"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3ObjectChecksumFunctionConfig(path, "MD5", 0),
+				ExpectError: regexache.MustCompile(`algorithm[\s\n]*must[\s\n]*be[\s\n]*one[\s\n]*of`),
+			},
+		},
+	})
+}
+
+func testS3ObjectChecksumFunctionConfig(path, algorithm string, partSize int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::s3_object_checksum(%[1]q, %[2]q, %[3]d)
+}
+`, path, algorithm, partSize)
+}
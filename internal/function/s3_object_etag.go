@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"crypto/md5" // nosemgrep: go.lang.security.audit.crypto.use_of_weak_crypto.use-of-md5
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = s3ObjectETagFunction{}
+
+func NewS3ObjectETagFunction() function.Function {
+	return &s3ObjectETagFunction{}
+}
+
+type s3ObjectETagFunction struct{}
+
+func (f s3ObjectETagFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_object_etag"
+}
+
+func (f s3ObjectETagFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "s3_object_etag Function",
+		MarkdownDescription: "Computes the entity tag (ETag) that Amazon S3 returns for an object uploaded from a local file " +
+			"without server-side encryption using AWS KMS keys or customer-provided keys. A part size of `0` computes the ETag of a single-part upload.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "path",
+				MarkdownDescription: "Path to the local file",
+			},
+			function.Int64Parameter{
+				Name:                "part_size",
+				MarkdownDescription: "Multipart upload part size in bytes, or `0` for a single-part upload",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f s3ObjectETagFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path string
+	var partSize int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &path, &partSize))
+	if resp.Error != nil {
+		return
+	}
+
+	sums, err := hashS3ObjectParts(path, partSize, md5.New)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, s3ObjectETag(sums)))
+}
+
+// s3ObjectETag returns the ETag for an object given the MD5 digests of its parts.
+// The ETag of a multipart upload is the MD5 digest of the concatenated part
+// digests, suffixed with the number of parts.
+func s3ObjectETag(sums [][]byte) string {
+	if len(sums) == 1 {
+		return hex.EncodeToString(sums[0])
+	}
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(compositeDigest(md5.New, sums)), len(sums))
+}
+
+// hashS3ObjectParts returns the digest of each part of the specified file as it
+// would be uploaded to Amazon S3. A part size of 0, or a file no larger than the
+// part size, results in a single part.
+func hashS3ObjectParts(path string, partSize int64, newHash func() hash.Hash) ([][]byte, error) {
+	if partSize < 0 || (partSize > 0 && partSize < manager.MinUploadPartSize) {
+		return nil, fmt.Errorf("part size (%d) must be 0 or at least %d bytes", partSize, manager.MinUploadPartSize)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if partSize == 0 || fi.Size() <= partSize {
+		partSize = max(fi.Size(), 1)
+	}
+
+	if n := (fi.Size() + partSize - 1) / partSize; n > int64(manager.MaxUploadParts) {
+		return nil, fmt.Errorf("file size (%d) requires %d parts with part size (%d), more than the maximum of %d", fi.Size(), n, partSize, manager.MaxUploadParts)
+	}
+
+	var sums [][]byte
+	for {
+		h := newHash()
+		n, err := io.CopyN(h, f, partSize)
+
+		if n > 0 || len(sums) == 0 {
+			sums = append(sums, h.Sum(nil))
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sums, nil
+}
+
+// compositeDigest returns the digest of the concatenated part digests.
+func compositeDigest(newHash func() hash.Hash, sums [][]byte) []byte {
+	h := newHash()
+	for _, sum := range sums {
+		h.Write(sum)
+	}
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3ObjectETagFunction_singlePart(t *testing.T) {
+	t.Parallel()
+	path := testS3ObjectFunctionFile(t, []byte("hello world"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3ObjectETagFunctionConfig(path, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "5eb63bbbe01eeed093cb22bb8f5acdc3"),
+				),
+			},
+			{
+				Config: testS3ObjectETagFunctionConfig(path, 5242880),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "5eb63bbbe01eeed093cb22bb8f5acdc3"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3ObjectETagFunction_multipart(t *testing.T) {
+	t.Parallel()
+	path := testS3ObjectFunctionFile(t, testS3ObjectFunctionMultipartContent())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3ObjectETagFunctionConfig(path, 5242880),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "19019d16dd0f439825c39a2c5b9178fd-3"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3ObjectETagFunction_invalidPartSize(t *testing.T) {
+	t.Parallel()
+	path := testS3ObjectFunctionFile(t, []byte("hello world"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3ObjectETagFunctionConfig(path, 1024),
+				ExpectError: regexache.MustCompile(`part[\s\n]*size[\s\n]*\(1024\)[\s\n]*must`),
+			},
+		},
+	})
+}
+
+// testS3ObjectFunctionFile writes the specified content to a temporary file and returns its path.
+func testS3ObjectFunctionFile(t *testing.T, content []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "object")
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+// testS3ObjectFunctionMultipartContent returns 12 MiB of content, which is uploaded in 3 parts of 5 MiB.
+func testS3ObjectFunctionMultipartContent() []byte {
+	b := make([]byte, 12*1024*1024)
+	for i := range b {
+		b[i] = byte(i)
+	}
+
+	return b
+}
+
+func testS3ObjectETagFunctionConfig(path string, partSize int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::s3_object_etag(%[1]q, %[2]d)
+}
+`, path, partSize)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+	_ "time/tzdata" // Embed the time zone database so that results do not depend on the host.
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/schedule"
+)
+
+const (
+	scheduleExpressionNextMaxCount = 1000
+)
+
+var _ function.Function = scheduleExpressionNextFunction{}
+
+func NewScheduleExpressionNextFunction() function.Function {
+	return &scheduleExpressionNextFunction{}
+}
+
+type scheduleExpressionNextFunction struct{}
+
+func (f scheduleExpressionNextFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_expression_next"
+}
+
+func (f scheduleExpressionNextFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_expression_next Function",
+		MarkdownDescription: "Computes the next times an Amazon EventBridge or EventBridge Scheduler schedule expression fires " +
+			"after the specified start time. Returns a list of RFC 3339 timestamps in the specified time zone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression",
+			},
+			function.StringParameter{
+				Name:                "timezone",
+				MarkdownDescription: "IANA time zone name the expression is evaluated in, for example `UTC` or `America/New_York`",
+			},
+			function.StringParameter{
+				Name:                "start",
+				MarkdownDescription: "RFC 3339 timestamp after which fire times are computed",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: fmt.Sprintf("Maximum number of fire times to return, between 1 and %d", scheduleExpressionNextMaxCount),
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleExpressionNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, timezone, start string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &timezone, &start, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("invalid time zone %q: %s", timezone, err)))
+		return
+	}
+
+	e, err := schedule.Parse(expression, loc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	next, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("invalid RFC 3339 timestamp %q: %s", start, err)))
+		return
+	}
+
+	if count < 1 || count > scheduleExpressionNextMaxCount {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, fmt.Sprintf("count must be between 1 and %d", scheduleExpressionNextMaxCount)))
+		return
+	}
+
+	result := make([]string, 0, count)
+	for range count {
+		if next = e.Next(next); next.IsZero() {
+			break
+		}
+
+		result = append(result, next.In(loc).Format(time.RFC3339))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
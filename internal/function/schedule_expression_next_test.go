@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleExpressionNextFunction_cron(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(15 10 ? * MON-FRI *)", "UTC", "2025-01-03T11:00:00Z", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `["2025-01-06T10:15:00Z","2025-01-07T10:15:00Z"]`),
+				),
+			},
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 9 * * ? *)", "America/New_York", "2025-03-08T00:00:00Z", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `["2025-03-08T09:00:00-05:00","2025-03-09T09:00:00-04:00"]`),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_rate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("rate(12 hours)", "UTC", "2025-01-01T00:00:00Z", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `["2025-01-01T12:00:00Z","2025-01-02T00:00:00Z","2025-01-02T12:00:00Z"]`),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_at(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("at(2025-01-01T12:00:00)", "UTC", "2025-01-01T00:00:00Z", 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `["2025-01-01T12:00:00Z"]`),
+				),
+			},
+			{
+				Config: testScheduleExpressionNextFunctionConfig("at(2025-01-01T12:00:00)", "UTC", "2025-06-01T00:00:00Z", 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[]`),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(5 minutes)", "Mars/Olympus_Mons", "2025-01-01T00:00:00Z", 1),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*time[\s\n]*zone`),
+			},
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(5 minutes)", "UTC", "yesterday", 1),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*RFC[\s\n]*3339[\s\n]*timestamp`),
+			},
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(5 minutes)", "UTC", "2025-01-01T00:00:00Z", 0),
+				ExpectError: regexache.MustCompile(`count[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+		},
+	})
+}
+
+func testScheduleExpressionNextFunctionConfig(expression, timezone, start string, count int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::schedule_expression_next(%[1]q, %[2]q, %[3]q, %[4]d))
+}
+`, expression, timezone, start, count)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/schedule"
+)
+
+var _ function.Function = scheduleExpressionValidateFunction{}
+
+func NewScheduleExpressionValidateFunction() function.Function {
+	return &scheduleExpressionValidateFunction{}
+}
+
+type scheduleExpressionValidateFunction struct{}
+
+func (f scheduleExpressionValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_expression_validate"
+}
+
+func (f scheduleExpressionValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_expression_validate Function",
+		MarkdownDescription: "Validates an Amazon EventBridge or EventBridge Scheduler `cron()`, `rate()` or `at()` schedule expression. " +
+			"Returns `true` if the expression is valid, otherwise an error describing the problem is raised.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression to validate",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f scheduleExpressionValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := schedule.Parse(expression, nil); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, true))
+}
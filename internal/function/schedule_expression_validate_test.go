@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleExpressionValidateFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("cron(15 10 ? * MON-FRI *)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("rate(5 minutes)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("at(2030-11-20T13:00:00)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionValidateFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("cron(0 8 1 * MON *)"),
+				ExpectError: regexache.MustCompile(`exactly[\s\n]*one[\s\n]*of[\s\n]*day-of-month[\s\n]*or[\s\n]*day-of-week`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("rate(1 minutes)"),
+				ExpectError: regexache.MustCompile(`unit[\s\n]*must[\s\n]*be[\s\n]*singular`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("every day"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*schedule[\s\n]*expression`),
+			},
+		},
+	})
+}
+
+func testScheduleExpressionValidateFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::schedule_expression_validate(%[1]q)
+}
+`, arg)
+}
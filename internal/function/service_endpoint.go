@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = serviceEndpointFunction{}
+
+func NewServiceEndpointFunction() function.Function {
+	return &serviceEndpointFunction{}
+}
+
+type serviceEndpointFunction struct{}
+
+func (f serviceEndpointFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "service_endpoint"
+}
+
+func (f serviceEndpointFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "service_endpoint Function",
+		MarkdownDescription: "Builds the regional endpoint hostname for an AWS service using the DNS suffix of the " +
+			"partition of the specified Region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Endpoint prefix of the service, for example `ec2` or `sts`",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f serviceEndpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service, region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &service, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	partition, err := partitionForServiceAndRegion(service, region)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result := fmt.Sprintf("%s.%s.%s", service, region, partition.DNSSuffix())
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
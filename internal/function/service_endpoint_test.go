@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestServiceEndpointFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceEndpointFunctionConfig("ec2", "us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ec2.us-west-2.amazonaws.com"),
+				),
+			},
+			{
+				Config: testServiceEndpointFunctionConfig("sts", "cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "sts.cn-north-1.amazonaws.com.cn"),
+				),
+			},
+			{
+				Config: testServiceEndpointFunctionConfig("sts", "us-gov-west-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "sts.us-gov-west-1.amazonaws.com"),
+				),
+			},
+			{
+				Config: testServiceEndpointFunctionConfig("sts", "us-iso-east-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "sts.us-iso-east-1.c2s.ic.gov"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testServiceEndpointFunctionConfig("", "us-west-2"),
+				ExpectError: regexache.MustCompile(`service[\s\n]*must[\s\n]*not[\s\n]*be[\s\n]*empty`),
+			},
+			{
+				Config:      testServiceEndpointFunctionConfig("ec2", ""),
+				ExpectError: regexache.MustCompile(`region[\s\n]*must[\s\n]*not[\s\n]*be[\s\n]*empty`),
+			},
+		},
+	})
+}
+
+func testServiceEndpointFunctionConfig(service, region string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::service_endpoint(%[1]q, %[2]q)
+}
+`, service, region)
+}
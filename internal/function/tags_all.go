@@ -0,0 +1,480 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ignoreTagsKeyKeys        = "keys"
+	ignoreTagsKeyKeyPrefixes = "key_prefixes"
+	ignoreTagsKeyRules       = "rules"
+)
+
+var _ function.Function = tagsAllFunction{}
+
+func NewTagsAllFunction() function.Function {
+	return &tagsAllFunction{}
+}
+
+type tagsAllFunction struct{}
+
+func (f tagsAllFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_all"
+}
+
+func (f tagsAllFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_all Function",
+		MarkdownDescription: "Computes the value of a resource's `tags_all` attribute from its `tags`, the provider's " +
+			"default tags and the provider's ignore tags configuration, including any rules scoped to the resource's type and service.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				MarkdownDescription: "Map of resource tags",
+				ElementType:         types.StringType,
+			},
+			function.DynamicParameter{
+				Name: "default_tags",
+				MarkdownDescription: "Provider default tags, either a map of tags or an object with optional `tags` and " +
+					"`rules` attributes mirroring the provider's `default_tags` block",
+			},
+			function.DynamicParameter{
+				Name: "ignore_tags",
+				MarkdownDescription: "Provider ignore tags configuration, an object with optional `keys`, `key_prefixes` " +
+					"and `rules` attributes mirroring the provider's `ignore_tags` block",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name: "resource",
+			MarkdownDescription: "Optional object with `type` (for example `aws_autoscaling_group`) and `service` " +
+				"(for example `autoscaling`) attributes, used to resolve rules scoped by resource type and service",
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsAllFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+	var defaultTagsArg, ignoreTagsArg types.Dynamic
+	var resourceArgs []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags, &defaultTagsArg, &ignoreTagsArg, &resourceArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	defaultConfig, err := expandTagsAllDefaultTags(ctx, defaultTagsArg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	ignoreConfig, err := expandTagsAllIgnoreTags(ctx, ignoreTagsArg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
+
+	typeName, servicePackageName, err := expandTagsAllResource(resourceArgs)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, err.Error()))
+		return
+	}
+
+	defaultConfig = defaultConfig.ForResource(typeName, servicePackageName)
+	ignoreConfig = ignoreConfig.ForResource(typeName)
+
+	result := defaultConfig.MergeTags(tftags.New(ctx, tags)).IgnoreAWS().IgnoreConfig(ignoreConfig)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result.Map()))
+}
+
+// expandTagsAllDefaultTags expands the default_tags argument, either a map of tags
+// or an object with optional `tags`, `rules`, `exclude_resource_types` and `exclude_services` attributes.
+func expandTagsAllDefaultTags(ctx context.Context, arg types.Dynamic) (*tftags.DefaultConfig, error) {
+	v, err := dynamicValueToGo(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	tfMap, ok := v.(map[string]any)
+	if !ok {
+		if v == nil {
+			return &tftags.DefaultConfig{}, nil
+		}
+		return nil, errors.New("must be a map of tags or an object")
+	}
+
+	// A map of tags.
+	if !slices.ContainsFunc(mapValues(tfMap), func(v any) bool { _, ok := v.(string); return !ok }) {
+		return &tftags.DefaultConfig{Tags: tftags.New(ctx, tfMap)}, nil
+	}
+
+	defaultConfig := &tftags.DefaultConfig{}
+	for k, v := range tfMap {
+		switch k {
+		case "tags":
+			tags, err := expandStringMap(v)
+			if err != nil {
+				return nil, fmt.Errorf("tags: %w", err)
+			}
+			defaultConfig.Tags = tftags.New(ctx, tags)
+		case "rules":
+			tfList, ok := v.([]any)
+			if !ok {
+				return nil, errors.New("rules must be a list of objects")
+			}
+
+			for i, v := range tfList {
+				rule, err := expandTagsAllDefaultTagsRule(ctx, v)
+				if err != nil {
+					return nil, fmt.Errorf("rules[%d]: %w", i, err)
+				}
+				defaultConfig.Rules = append(defaultConfig.Rules, rule)
+			}
+		case "exclude_resource_types":
+			defaultConfig.ExcludeResourceTypes, err = expandStringList(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+		case "exclude_services":
+			defaultConfig.ExcludeServices, err = expandServicePackageNames(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported key %q, expected %q, %q, %q or %q", k, "tags", "rules", "exclude_resource_types", "exclude_services")
+		}
+	}
+
+	return defaultConfig, nil
+}
+
+func expandTagsAllDefaultTagsRule(ctx context.Context, v any) (tftags.DefaultTagsRule, error) {
+	var rule tftags.DefaultTagsRule
+
+	tfMap, ok := v.(map[string]any)
+	if !ok {
+		return rule, errors.New("must be an object")
+	}
+
+	for k, v := range tfMap {
+		var err error
+
+		switch k {
+		case "tags":
+			var tags map[string]string
+			tags, err = expandStringMap(v)
+			rule.Tags = tftags.New(ctx, tags)
+		case "include_resource_types":
+			rule.IncludeResourceTypes, err = expandStringList(v)
+		case "exclude_resource_types":
+			rule.ExcludeResourceTypes, err = expandStringList(v)
+		case "include_services":
+			rule.IncludeServices, err = expandServicePackageNames(v)
+		case "exclude_services":
+			rule.ExcludeServices, err = expandServicePackageNames(v)
+		default:
+			err = errors.New("unsupported key")
+		}
+
+		if err != nil {
+			return rule, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return rule, nil
+}
+
+// expandTagsAllIgnoreTags expands the ignore_tags argument, an object with
+// optional `keys`, `key_prefixes` and `rules` attributes.
+func expandTagsAllIgnoreTags(ctx context.Context, arg types.Dynamic) (*tftags.IgnoreConfig, error) {
+	v, err := dynamicValueToGo(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	ignoreConfig := &tftags.IgnoreConfig{}
+	if v == nil {
+		return ignoreConfig, nil
+	}
+
+	tfMap, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("must be an object")
+	}
+
+	for k, v := range tfMap {
+		switch k {
+		case ignoreTagsKeyKeys:
+			keys, err := expandStringList(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			ignoreConfig.Keys = tftags.New(ctx, keys)
+		case ignoreTagsKeyKeyPrefixes:
+			keyPrefixes, err := expandStringList(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			ignoreConfig.KeyPrefixes = tftags.New(ctx, keyPrefixes)
+		case ignoreTagsKeyRules:
+			tfList, ok := v.([]any)
+			if !ok {
+				return nil, errors.New("rules must be a list of objects")
+			}
+
+			for i, v := range tfList {
+				rule, err := expandTagsAllIgnoreTagsRule(v)
+				if err != nil {
+					return nil, fmt.Errorf("rules[%d]: %w", i, err)
+				}
+				ignoreConfig.Rules = append(ignoreConfig.Rules, rule)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported key %q, expected %q, %q or %q", k, ignoreTagsKeyKeys, ignoreTagsKeyKeyPrefixes, ignoreTagsKeyRules)
+		}
+	}
+
+	return ignoreConfig, nil
+}
+
+func expandTagsAllIgnoreTagsRule(v any) (tftags.IgnoreTagsRule, error) {
+	var rule tftags.IgnoreTagsRule
+
+	tfMap, ok := v.(map[string]any)
+	if !ok {
+		return rule, errors.New("must be an object")
+	}
+
+	for k, v := range tfMap {
+		var err error
+
+		switch k {
+		case ignoreTagsKeyKeys:
+			rule.Keys, err = expandStringList(v)
+		case ignoreTagsKeyKeyPrefixes:
+			rule.KeyPrefixes, err = expandStringList(v)
+		case "resource_types":
+			rule.ResourceTypes, err = expandStringList(v)
+		case "value_regex":
+			s, ok := v.(string)
+			switch {
+			case v == nil:
+			case !ok:
+				err = errors.New("must be a string")
+			default:
+				rule.ValueRegex, err = regexp.Compile(s)
+			}
+		default:
+			err = errors.New("unsupported key")
+		}
+
+		if err != nil {
+			return rule, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	if len(rule.Keys) == 0 && len(rule.KeyPrefixes) == 0 && rule.ValueRegex == nil {
+		return rule, errors.New("at least one of keys, key_prefixes or value_regex must be specified")
+	}
+
+	return rule, nil
+}
+
+// expandTagsAllResource expands the optional resource argument into a resource type and service package name.
+func expandTagsAllResource(args []types.Dynamic) (string, string, error) {
+	switch len(args) {
+	case 0:
+		return "", "", nil
+	case 1:
+	default:
+		return "", "", errors.New("at most one resource may be specified")
+	}
+
+	v, err := dynamicValueToGo(args[0])
+	if err != nil {
+		return "", "", err
+	}
+
+	tfMap, ok := v.(map[string]any)
+	if !ok {
+		return "", "", errors.New("must be an object")
+	}
+
+	var typeName, servicePackageName string
+	for k, v := range tfMap {
+		s, ok := v.(string)
+		if !ok && v != nil {
+			return "", "", fmt.Errorf("%s must be a string", k)
+		}
+
+		switch k {
+		case names.AttrType:
+			typeName = s
+		case "service":
+			if s == "" {
+				continue
+			}
+			services, err := expandServicePackageNames([]any{s})
+			if err != nil {
+				return "", "", fmt.Errorf("%s: %w", k, err)
+			}
+			servicePackageName = services[0]
+		default:
+			return "", "", fmt.Errorf("unsupported key %q, expected %q or %q", k, names.AttrType, "service")
+		}
+	}
+
+	return typeName, servicePackageName, nil
+}
+
+// expandServicePackageNames expands a list of service names or aliases into service package names.
+func expandServicePackageNames(v any) ([]string, error) {
+	services, err := expandStringList(v)
+	if err != nil {
+		return nil, err
+	}
+
+	servicePackageNames := make([]string, 0, len(services))
+	for _, service := range services {
+		if slices.Contains(names.ProviderPackages(), service) {
+			servicePackageNames = append(servicePackageNames, service)
+			continue
+		}
+
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported service %q", service)
+		}
+		servicePackageNames = append(servicePackageNames, servicePackageName)
+	}
+
+	return servicePackageNames, nil
+}
+
+func expandStringList(v any) ([]string, error) {
+	tfList, ok := v.([]any)
+	if !ok {
+		if v == nil {
+			return nil, nil
+		}
+		return nil, errors.New("must be a list of strings")
+	}
+
+	result := make([]string, 0, len(tfList))
+	for _, v := range tfList {
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.New("must be a list of strings")
+		}
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+func expandStringMap(v any) (map[string]string, error) {
+	tfMap, ok := v.(map[string]any)
+	if !ok {
+		if v == nil {
+			return nil, nil
+		}
+		return nil, errors.New("must be a map of strings")
+	}
+
+	result := make(map[string]string, len(tfMap))
+	for k, v := range tfMap {
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.New("must be a map of strings")
+		}
+		result[k] = s
+	}
+
+	return result, nil
+}
+
+func mapValues(m map[string]any) []any {
+	values := make([]any, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// dynamicValueToGo converts a known value of any type into the equivalent Go value.
+// Objects and maps become map[string]any, lists, sets and tuples become []any, and
+// strings and bools become string and bool, and numbers become their string representation.
+func dynamicValueToGo(v attr.Value) (any, error) {
+	if v == nil || v.IsNull() {
+		return nil, nil
+	}
+
+	if v.IsUnknown() {
+		return nil, errors.New("value must be known")
+	}
+
+	var elems []attr.Value
+	var attrs map[string]attr.Value
+
+	switch v := v.(type) {
+	case types.Dynamic:
+		return dynamicValueToGo(v.UnderlyingValue())
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.Number:
+		return v.ValueBigFloat().Text('f', -1), nil
+	case types.List:
+		elems = v.Elements()
+	case types.Set:
+		elems = v.Elements()
+	case types.Tuple:
+		elems = v.Elements()
+	case types.Map:
+		attrs = v.Elements()
+	case types.Object:
+		attrs = v.Attributes()
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", v.Type(context.Background()))
+	}
+
+	if attrs != nil {
+		result := make(map[string]any, len(attrs))
+		for k, v := range attrs {
+			v, err := dynamicValueToGo(v)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = v
+		}
+		return result, nil
+	}
+
+	result := make([]any, 0, len(elems))
+	for _, v := range elems {
+		v, err := dynamicValueToGo(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsAllFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = jsonencode(provider::aws::tags_all(
+    {
+      Name        = "example"
+      Environment = "test"
+    },
+    {
+      Environment = "production"
+      Owner       = "platform"
+      CostCenter  = "1234"
+      "aws:x"     = "ignored"
+    },
+    {
+      keys         = ["CostCenter"]
+      key_prefixes = ["Own"]
+    },
+  ))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Environment":"test","Name":"example"}`),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = jsonencode(provider::aws::tags_all({ Name = "example" }, { Owner = "platform" }, {}))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Name":"example","Owner":"platform"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsAllFunction_rules(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsAllFunctionConfig_rules(`{ type = "aws_autoscaling_group", service = "autoscaling" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Name":"example","Team":"compute"}`),
+				),
+			},
+			{
+				Config: testTagsAllFunctionConfig_rules(`{ type = "aws_s3_bucket", service = "s3" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Environment":"production","Name":"example","Scope":"all"}`),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = jsonencode(provider::aws::tags_all({ Name = "example" }, {
+    tags                   = { Environment = "production" }
+    exclude_resource_types = ["aws_s3_bucket"]
+    rules                  = [{ tags = { Scope = "all" } }]
+  }, {}, { type = "aws_s3_bucket", service = "s3" }))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Name":"example","Scope":"all"}`),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = jsonencode(provider::aws::tags_all({ Name = "example" }, {}, {}))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Name":"example"}`),
+				),
+			},
+		},
+	})
+}
+
+func testTagsAllFunctionConfig_rules(resourceArg string) string {
+	return fmt.Sprintf(`
+locals {
+  default_tags = {
+    tags = {
+      Environment = "production"
+    }
+    rules = [
+      {
+        tags             = { Team = "compute" }
+        include_services = ["autoscaling"]
+      },
+      {
+        tags                   = { Scope = "all" }
+        exclude_resource_types = ["aws_autoscaling_group"]
+      },
+    ]
+  }
+
+  ignore_tags = {
+    rules = [
+      {
+        value_regex    = "^prod"
+        resource_types = ["aws_autoscaling_group"]
+      },
+    ]
+  }
+}
+
+output "test" {
+  value = jsonencode(provider::aws::tags_all({ Name = "example" }, local.default_tags, local.ignore_tags, %[1]s))
+}
+`, resourceArg)
+}
+
+func TestTagsAllFunction_invalidIgnoreTags(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::tags_all({ Name = "example" }, {}, { prefixes = ["a"] })
+}
+`,
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*key`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::aws::tags_all({ Name = "example" }, {}, { rules = [{ resource_types = ["aws_instance"] }] })
+}
+`,
+				ExpectError: regexache.MustCompile(`at[\s\n]*least[\s\n]*one[\s\n]*of`),
+			},
+		},
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = tagsFromListFunction{}
+
+func NewTagsFromListFunction() function.Function {
+	return &tagsFromListFunction{}
+}
+
+type tagsFromListFunction struct{}
+
+func (f tagsFromListFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_from_list"
+}
+
+func (f tagsFromListFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_from_list Function",
+		MarkdownDescription: "Converts a list of objects with `Key` and `Value` (or `key` and `value`) attributes to a map of tags. " +
+			"If the `propagate_at_launch` option is specified, only objects whose `PropagateAtLaunch` (or `propagate_at_launch`) " +
+			"attribute has that value are converted. Other attributes are ignored and AWS system tags (keys prefixed with `aws:`) are removed.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "tags",
+				MarkdownDescription: "List of tag objects",
+				ElementType: types.MapType{
+					ElemType: types.StringType,
+				},
+			},
+		},
+		VariadicParameter: tagsListOptionsParameter(),
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsFromListFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var elems []map[string]string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &elems, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := expandTagsListOptions(options)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	tags := make(map[string]string, len(elems))
+	for i, elem := range elems {
+		key, ok := tagsListElementValue(elem, "Key", "key")
+		if !ok {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("element %d has no Key attribute", i)))
+			return
+		}
+		value, _ := tagsListElementValue(elem, "Value", "value")
+
+		propagateAtLaunch := false
+		if v, ok := tagsListElementValue(elem, "PropagateAtLaunch", "propagate_at_launch"); ok {
+			propagateAtLaunch, err = strconv.ParseBool(v)
+			if err != nil {
+				resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("element %d PropagateAtLaunch attribute must be a bool", i)))
+				return
+			}
+		}
+
+		if opts.propagateAtLaunch != nil && propagateAtLaunch != *opts.propagateAtLaunch {
+			continue
+		}
+
+		if _, ok := tags[key]; ok {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("duplicate tag key %q", key)))
+			return
+		}
+		tags[key] = value
+	}
+
+	kvtags, err := ignoreSystemTags(ctx, tags, opts.services)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, kvtags.Map()))
+}
+
+// tagsListElementValue returns the value of the first of the specified attributes present in a tag list element.
+func tagsListElementValue(elem map[string]string, attrs ...string) (string, bool) {
+	for _, attr := range attrs {
+		if v, ok := elem[attr]; ok {
+			return v, true
+		}
+	}
+
+	return "", false
+}
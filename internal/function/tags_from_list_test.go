@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsFromListFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = jsonencode(provider::aws::tags_from_list([
+    { Key = "Name", Value = "example" },
+    { key = "Environment", value = "test", propagate_at_launch = true },
+    { Key = "aws:cloudformation:stack-name", Value = "ignored" },
+  ]))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Environment":"test","Name":"example"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsFromListFunction_propagateAtLaunch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = jsonencode(provider::aws::tags_from_list([
+    { Key = "Name", Value = "example", PropagateAtLaunch = true },
+    { Key = "Environment", Value = "test", PropagateAtLaunch = false },
+    { key = "Owner", value = "team", propagate_at_launch = true },
+  ], { propagate_at_launch = true }))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Name":"example","Owner":"team"}`),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::aws::tags_from_list([
+    { Key = "Name", Value = "example", PropagateAtLaunch = "sometimes" },
+  ])
+}
+`,
+				ExpectError: regexache.MustCompile(`PropagateAtLaunch[\s\n]*attribute[\s\n]*must[\s\n]*be[\s\n]*a[\s\n]*bool`),
+			},
+		},
+	})
+}
+
+func TestTagsFromListFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::tags_from_list([{ Name = "example" }])
+}
+`,
+				ExpectError: regexache.MustCompile(`has[\s\n]*no[\s\n]*Key[\s\n]*attribute`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::aws::tags_from_list([
+    { Key = "Name", Value = "example" },
+    { Key = "Name", Value = "duplicate" },
+  ])
+}
+`,
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*tag[\s\n]*key`),
+			},
+		},
+	})
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var tagsListElementAttrTypes = map[string]attr.Type{
+	"Key":   types.StringType,
+	"Value": types.StringType,
+}
+
+// tagsListPropagateAtLaunchElementAttrTypes are the attributes of an Auto Scaling group tag list element.
+var tagsListPropagateAtLaunchElementAttrTypes = map[string]attr.Type{
+	"Key":               types.StringType,
+	"PropagateAtLaunch": types.BoolType,
+	"Value":             types.StringType,
+}
+
+var _ function.Function = tagsToListFunction{}
+
+func NewTagsToListFunction() function.Function {
+	return &tagsToListFunction{}
+}
+
+type tagsToListFunction struct{}
+
+func (f tagsToListFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_to_list"
+}
+
+func (f tagsToListFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_to_list Function",
+		MarkdownDescription: "Converts a map of tags to a list of objects with `Key` and `Value` attributes, sorted by key. " +
+			"If the `propagate_at_launch` option is specified, each object also has a `PropagateAtLaunch` attribute. " +
+			"AWS system tags (keys prefixed with `aws:`) are removed.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				MarkdownDescription: "Map of tags",
+				ElementType:         types.StringType,
+			},
+		},
+		VariadicParameter: tagsListOptionsParameter(),
+		Return:            function.DynamicReturn{},
+	}
+}
+
+func (f tagsToListFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := expandTagsListOptions(options)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	kvtags, err := ignoreSystemTags(ctx, tags, opts.services)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	keys := kvtags.Keys()
+	slices.Sort(keys)
+
+	attrTypes := tagsListElementAttrTypes
+	if opts.propagateAtLaunch != nil {
+		attrTypes = tagsListPropagateAtLaunchElementAttrTypes
+	}
+
+	elems := make([]attr.Value, 0, len(keys))
+	for _, k := range keys {
+		attrs := map[string]attr.Value{
+			"Key":   types.StringValue(k),
+			"Value": types.StringValue(kvtags.KeyTagData(k).ValueString()),
+		}
+		if opts.propagateAtLaunch != nil {
+			attrs["PropagateAtLaunch"] = types.BoolValue(*opts.propagateAtLaunch)
+		}
+
+		elem, d := types.ObjectValue(attrTypes, attrs)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+		elems = append(elems, elem)
+	}
+
+	result, d := types.ListValue(types.ObjectType{AttrTypes: attrTypes}, elems)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.DynamicValue(result)))
+}
+
+// tagsListOptionsParameter is the optional final parameter of the tag list conversion functions.
+func tagsListOptionsParameter() function.Parameter {
+	return function.DynamicParameter{
+		Name: "options",
+		MarkdownDescription: "Optional service package name (for example `elasticbeanstalk`) used to determine " +
+			"which system tags are removed in addition to AWS system tags, or an object with optional `service` " +
+			"and `propagate_at_launch` attributes",
+	}
+}
+
+// tagsListOptions are the optional settings of the tag list conversion functions.
+type tagsListOptions struct {
+	propagateAtLaunch *bool
+	services          []string
+}
+
+// expandTagsListOptions expands the optional final argument of the tag list conversion functions.
+// The argument is either a service package name or an object with optional `service` and
+// `propagate_at_launch` attributes.
+func expandTagsListOptions(options []types.Dynamic) (tagsListOptions, error) {
+	var opts tagsListOptions
+
+	switch len(options) {
+	case 0:
+		return opts, nil
+	case 1:
+	default:
+		return opts, errors.New("at most one service may be specified")
+	}
+
+	switch v := options[0].UnderlyingValue().(type) {
+	case types.String:
+		opts.services = append(opts.services, v.ValueString())
+	case types.Object:
+		for name, v := range v.Attributes() {
+			switch name {
+			case "propagate_at_launch":
+				v, ok := v.(types.Bool)
+				if !ok {
+					return opts, errors.New("propagate_at_launch must be a bool")
+				}
+				opts.propagateAtLaunch = v.ValueBoolPointer()
+			case "service":
+				v, ok := v.(types.String)
+				if !ok {
+					return opts, errors.New("service must be a string")
+				}
+				if !v.IsNull() {
+					opts.services = append(opts.services, v.ValueString())
+				}
+			default:
+				return opts, fmt.Errorf("unsupported option %q", name)
+			}
+		}
+	default:
+		return opts, errors.New("options must be a service name or an object")
+	}
+
+	return opts, nil
+}
+
+// tagsServiceParameter is the optional final parameter of the tag conversion
+// functions, used to remove service-specific system tags.
+func tagsServiceParameter() function.Parameter {
+	return function.StringParameter{
+		Name: "service",
+		MarkdownDescription: "Optional service package name (for example `elasticbeanstalk`) used to determine " +
+			"which system tags are removed in addition to AWS system tags",
+	}
+}
+
+// ignoreSystemTags removes AWS system tags, and any system tags specific to the
+// optional service, from the specified tags.
+func ignoreSystemTags(ctx context.Context, tags map[string]string, services []string) (tftags.KeyValueTags, error) {
+	switch len(services) {
+	case 0:
+		return tftags.New(ctx, tags).IgnoreAWS(), nil
+	case 1:
+		return tftags.New(ctx, tags).IgnoreSystem(services[0]), nil
+	default:
+		return nil, errors.New("at most one service may be specified")
+	}
+}
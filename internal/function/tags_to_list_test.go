@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsToListFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = jsonencode(provider::aws::tags_to_list({
+    Name                    = "example"
+    Environment             = "test"
+    "aws:cloudformation:id" = "ignored"
+  }))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[{"Key":"Environment","Value":"test"},{"Key":"Name","Value":"example"}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsToListFunction_service(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = jsonencode(provider::aws::tags_to_list({
+    Name                        = "example"
+    Environment                 = "test"
+    "elasticbeanstalk:env-name" = "ignored"
+  }, "elasticbeanstalk"))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[{"Key":"Environment","Value":"test"}]`),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::aws::tags_to_list({ Name = "example" }, "ec2", "s3")
+}
+`,
+				ExpectError: regexache.MustCompile(`at[\s\n]*most[\s\n]*one[\s\n]*service`),
+			},
+		},
+	})
+}
+
+func TestTagsToListFunction_propagateAtLaunch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = jsonencode(provider::aws::tags_to_list({
+    Name                    = "example"
+    Environment             = "test"
+    "aws:cloudformation:id" = "ignored"
+  }, { propagate_at_launch = true }))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[{"Key":"Environment","PropagateAtLaunch":true,"Value":"test"},{"Key":"Name","PropagateAtLaunch":true,"Value":"example"}]`),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = jsonencode(provider::aws::tags_to_list({
+    Name                        = "example"
+    "elasticbeanstalk:env-name" = "ignored"
+  }, { service = "elasticbeanstalk", propagate_at_launch = false }))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[]`),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::aws::tags_to_list({ Name = "example" }, { propagate = true })
+}
+`,
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*option`),
+			},
+		},
+	})
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = tagsURLEncodeFunction{}
+
+func NewTagsURLEncodeFunction() function.Function {
+	return &tagsURLEncodeFunction{}
+}
+
+type tagsURLEncodeFunction struct{}
+
+func (f tagsURLEncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_url_encode"
+}
+
+func (f tagsURLEncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_url_encode Function",
+		MarkdownDescription: "Encodes a map of tags as URL query parameters, the format used by Amazon S3 object tagging. " +
+			"AWS system tags (keys prefixed with `aws:`) are removed.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				MarkdownDescription: "Map of tags",
+				ElementType:         types.StringType,
+			},
+		},
+		VariadicParameter: tagsServiceParameter(),
+		Return:            function.StringReturn{},
+	}
+}
+
+func (f tagsURLEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+	var services []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags, &services))
+	if resp.Error != nil {
+		return
+	}
+
+	kvtags, err := ignoreSystemTags(ctx, tags, services)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, kvtags.URLEncode()))
+}
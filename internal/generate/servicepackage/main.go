@@ -31,6 +31,7 @@ func main() {
 	const (
 		filename                 = `service_package_gen.go`
 		endpointResolverFilename = `service_endpoint_resolver_gen.go`
+		listResourcesFilename    = `list_resources_gen.go`
 	)
 	g := common.NewGenerator()
 
@@ -117,6 +118,31 @@ func main() {
 			}
 		}
 
+		// SDK resources annotated with @ListResource get a generated list resource.
+		generatedSDKListResources := make(map[string]ResourceDatum, 0)
+		for key, value := range v.sdkResources {
+			if value.List == nil {
+				continue
+			}
+
+			if _, exists := v.sdkListResources[key]; exists {
+				g.Fatalf("SDK Resource %q declares ListResource but also has a List Resource", key)
+			}
+
+			if !value.HasResourceIdentity() {
+				g.Fatalf("SDK Resource %q declares ListResource but has no Resource Identity", key)
+			}
+
+			list := *value.List
+			list.ResourceFactoryName = value.FactoryName
+			list.TypeNamePrefix = listResourceTypeNamePrefix(value.FactoryName)
+			value.List = &list
+			value.FactoryName = list.TypeNamePrefix + "ResourceAsListResource"
+
+			v.sdkListResources[key] = value
+			generatedSDKListResources[key] = value
+		}
+
 		s := ServiceDatum{
 			GenerateClient:          l.GenerateClient(),
 			IsGlobal:                l.IsGlobal(),
@@ -181,6 +207,23 @@ func main() {
 			g.Fatalf("generating file (%s): %s", filename, err)
 		}
 
+		if len(generatedSDKListResources) > 0 {
+			g.Infof("Generating internal/service/%s/%s", servicePackage, listResourcesFilename)
+
+			s := s
+			s.SDKListResources = generatedSDKListResources
+
+			d = g.NewGoFileDestination(listResourcesFilename)
+
+			if err := d.BufferTemplate("listresources", listResourcesTmpl, s); err != nil {
+				g.Fatalf("generating %s list resources: %s", p, err)
+			}
+
+			if err := d.Write(); err != nil {
+				g.Fatalf("generating file (%s): %s", listResourcesFilename, err)
+			}
+		}
+
 		if p != "meta" && !l.IsClientSDKV1() {
 			g.Infof("Generating internal/service/%s/%s", servicePackage, endpointResolverFilename)
 
@@ -212,6 +255,10 @@ type ResourceDatum struct {
 	Name                              string // Friendly name (without service name), e.g. "Topic", not "SNS Topic"
 	IsGlobal                          bool
 	regionOverrideEnabled             bool
+	AssumeRoleOverrideEnabled         bool
+	PermissionsCreate                 []string
+	PermissionsUpdate                 []string
+	PermissionsDelete                 []string
 	TransparentTagging                bool
 	TagsIdentifierAttribute           string
 	TagsResourceType                  string
@@ -223,9 +270,36 @@ type ResourceDatum struct {
 	ImportIDHandler                   string
 	SetIDAttribute                    bool
 	HasIdentityFix                    bool
+	List                              *ListDatum
 	common.ResourceIdentity
 }
 
+// ListDatum describes the list resource generated for an SDK resource.
+type ListDatum struct {
+	Operation           string // List or Describe operation, e.g. "ListQueues"
+	Items               string // Output field containing the listed items, e.g. "QueueUrls"
+	ItemIdentifier      string // Item field containing the resource ID. Empty if the items are strings
+	ItemDisplayName     string // Item field containing the display name. Defaults to the resource ID
+	ItemName            string // Item field containing the value of NameAttribute. Empty if the items don't carry it
+	IDIsName            bool   // Whether the resource ID is the value of NameAttribute
+	ItemNameFunc        string // Function returning the value of NameAttribute from an item, e.g. parsed from an ARN
+	InputFunc           string // Function returning the operation's input. Defaults to an empty input
+	Paginator           string // Paginator constructor. Defaults to the AWS SDK for Go v2 paginator
+	NameAttribute       string // Resource attribute matched by `name_prefix`. Defaults to "name"
+	NamePrefixInput     string // Input field that `name_prefix` is pushed down to. Empty if not supported by the operation
+	ResourceFactoryName string
+	TypeNamePrefix      string
+}
+
+func (d ListDatum) ImportsAWS() bool {
+	return d.ItemIdentifier != "" || d.ItemDisplayName != "" || d.ItemName != "" || d.NamePrefixInput != ""
+}
+
+// MatchesNameBeforeRead returns whether `name_prefix` can be matched against the listed item, before the resource is read.
+func (d ListDatum) MatchesNameBeforeRead() bool {
+	return d.ItemName != "" || d.IDIsName || d.ItemNameFunc != ""
+}
+
 func (r ResourceDatum) IsARNFormatGlobal() bool {
 	return r.isARNFormatGlobal == arnFormatStateGlobal
 }
@@ -234,6 +308,10 @@ func (r ResourceDatum) HasAlternateARNAttribute() bool {
 	return r.IdentityAttributeName() != "" && r.IdentityAttributeName() != "arn"
 }
 
+func (d ResourceDatum) HasPermissions() bool {
+	return len(d.PermissionsCreate) > 0 || len(d.PermissionsUpdate) > 0 || len(d.PermissionsDelete) > 0
+}
+
 func (d ResourceDatum) RegionOverrideEnabled() bool {
 	return d.regionOverrideEnabled && !d.IsGlobal
 }
@@ -260,12 +338,46 @@ type ServiceDatum struct {
 	GoImports               []common.GoImport
 }
 
+func (s ServiceDatum) ListResourcesImportAWS() bool {
+	for _, v := range s.SDKListResources {
+		if v.List != nil && v.List.ImportsAWS() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s ServiceDatum) ListResourcesImportTags() bool {
+	for _, v := range s.SDKListResources {
+		if v.List != nil && v.TransparentTagging {
+			return true
+		}
+	}
+
+	return false
+}
+
 //go:embed service_package_gen.go.gtpl
 var tmpl string
 
 //go:embed endpoint_resolver.go.gtpl
 var endpointResolverTmpl string
 
+//go:embed list_resources_gen.go.gtpl
+var listResourcesTmpl string
+
+// listResourceTypeNamePrefix returns the prefix used for a generated list resource's type and factory names,
+// e.g. "queue" for "resourceQueue".
+func listResourceTypeNamePrefix(factoryName string) string {
+	name := strings.TrimPrefix(factoryName, "resource")
+	if name == "" {
+		return factoryName
+	}
+
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
 // Annotation processing.
 var (
 	annotation    = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
@@ -390,6 +502,26 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					}
 				}
 
+			case "AssumeRoleOverride":
+				d.AssumeRoleOverrideEnabled = true
+
+			case "Permissions":
+				for key, target := range map[string]*[]string{
+					"create": &d.PermissionsCreate,
+					"update": &d.PermissionsUpdate,
+					"delete": &d.PermissionsDelete,
+				} {
+					if attr, ok := args.Keyword[key]; ok {
+						for action := range strings.SplitSeq(attr, ";") {
+							if service, name, ok := strings.Cut(action, ":"); !ok || service == "" || name == "" {
+								v.errs = append(v.errs, fmt.Errorf("invalid Permissions/%s action (%s): %s", key, action, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+								continue
+							}
+							*target = append(*target, action)
+						}
+					}
+				}
+
 			case "Tags":
 				d.TransparentTagging = true
 
@@ -462,6 +594,40 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			case "IdentityFix":
 				d.HasIdentityFix = true
 
+			case "ListResource":
+				list := ListDatum{
+					Operation:       args.Keyword["operation"],
+					Items:           args.Keyword["items"],
+					ItemIdentifier:  args.Keyword["id"],
+					ItemDisplayName: args.Keyword["displayName"],
+					ItemName:        args.Keyword["name"],
+					ItemNameFunc:    args.Keyword["nameFunc"],
+					InputFunc:       args.Keyword["input"],
+					Paginator:       args.Keyword["paginator"],
+					NameAttribute:   "names.AttrName",
+					NamePrefixInput: args.Keyword["namePrefixInput"],
+				}
+
+				if attr, ok := args.Keyword["nameAttribute"]; ok {
+					list.NameAttribute = namesgen.ConstOrQuote(attr)
+				}
+
+				if attr, ok := args.Keyword["idIsName"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid idIsName value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						list.IDIsName = b
+					}
+				}
+
+				if list.Operation == "" || list.Items == "" {
+					v.errs = append(v.errs, fmt.Errorf("ListResource requires operation and items: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				d.List = &list
+
 			default:
 				if err := common.ParseResourceIdentity(annotationName, args, implementation, &d.ResourceIdentity, &d.goImports); err != nil {
 					v.errs = append(v.errs, fmt.Errorf("%s.%s: %w", v.packageName, v.functionName, err))
@@ -603,6 +769,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.errs = append(v.errs, fmt.Errorf("IdentityVersion not currently supported for Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				}
 
+				if d.List != nil {
+					v.errs = append(v.errs, fmt.Errorf("ListResource not currently supported for Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+
 			case "SDKDataSource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -697,7 +867,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkListResources[typeName] = d
 				}
 
-			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
+			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "AssumeRoleOverride", "Permissions", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity", "ListResource":
 				// Handled above.
 			case "ArnFormat", "IdAttrFormat", "NoImport", "Testing":
 				// Ignored.
@@ -6,6 +6,7 @@ package namevaluesfilters
 
 import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	imagebuildertypes "github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
 	licensemanagertypes "github.com/aws/aws-sdk-go-v2/service/licensemanager/types"
 	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
@@ -15,6 +16,28 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 
 // []*SERVICE.Filter handling
 
+// EC2Filters returns ec2 service filters.
+func (filters NameValuesFilters) EC2Filters() []ec2types.Filter {
+	m := filters.Map()
+
+	if len(m) == 0 {
+		return nil
+	}
+
+	result := make([]ec2types.Filter, 0, len(m))
+
+	for k, v := range m {
+		filter := ec2types.Filter{
+			Name:   aws.String(k),
+			Values: v,
+		}
+
+		result = append(result, filter)
+	}
+
+	return result
+}
+
 // ImageBuilderFilters returns imagebuilder service filters.
 func (filters NameValuesFilters) ImageBuilderFilters() []imagebuildertypes.Filter {
 	m := filters.Map()
@@ -44,5 +44,7 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	return func() tfprotov5.ProviderServer {
+		return newPermissionPreflightServer(muxServer.ProviderServer(), primary)
+	}, primary, nil
 }
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type resourceInjectAssumeRoleARNAttributeInterceptor struct{}
+
+func (r resourceInjectAssumeRoleARNAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrAssumeRoleARN]; !ok {
+			// Inject a top-level "assume_role_arn" attribute.
+			response.Schema.Attributes[names.AttrAssumeRoleARN] = resourceattribute.AssumeRoleARN()
+		}
+	}
+}
+
+// resourceInjectAssumeRoleARNAttribute injects a top-level "assume_role_arn" attribute into a resource's schema.
+func resourceInjectAssumeRoleARNAttribute() resourceSchemaInterceptor {
+	return &resourceInjectAssumeRoleARNAttributeInterceptor{}
+}
+
+type resourceForceNewIfAssumeRoleARNChangesInterceptor struct{}
+
+func (r resourceForceNewIfAssumeRoleARNChangesInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		// If the entire state is null, the resource is new.
+		if request.State.Raw.IsNull() {
+			return
+		}
+
+		var planRoleARN types.String
+		opts.response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &planRoleARN)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+
+		var stateRoleARN types.String
+		opts.response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &stateRoleARN)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+
+		if planRoleARN.IsUnknown() || conns.AssumeRoleARNAccountID(ctx, c, planRoleARN.ValueString()) != conns.AssumeRoleARNAccountID(ctx, c, stateRoleARN.ValueString()) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrAssumeRoleARN))
+		}
+	}
+}
+
+// resourceForceNewIfAssumeRoleARNChanges forces resource replacement if the value of the top-level `assume_role_arn` attribute changes the resource's AWS account.
+func resourceForceNewIfAssumeRoleARNChanges() resourceModifyPlanInterceptor {
+	return &resourceForceNewIfAssumeRoleARNChangesInterceptor{}
+}
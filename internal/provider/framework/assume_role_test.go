@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceForceNewIfAssumeRoleARNChangesInterceptor_ModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	const (
+		name              = "example"
+		providerAccountID = "123456789012"
+		otherAccountID    = "210987654321"
+	)
+
+	ctx := context.Background()
+	client := mockClient{accountID: providerAccountID}
+	icpt := resourceForceNewIfAssumeRoleARNChangesInterceptor{}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName:          schema.StringAttribute{Required: true},
+			names.AttrAssumeRoleARN: resourceattribute.AssumeRoleARN(),
+		},
+	}
+
+	tests := map[string]struct {
+		stateRoleARN          string
+		planRoleARN           string
+		expectRequiresReplace bool
+	}{
+		"role in other account": {
+			stateRoleARN:          "arn:aws:iam::" + otherAccountID + ":role/a",    //lintignore:AWSAT005
+			planRoleARN:           "arn:aws:iam::" + providerAccountID + ":role/a", //lintignore:AWSAT005
+			expectRequiresReplace: true,
+		},
+		"role added in other account": {
+			planRoleARN:           "arn:aws:iam::" + otherAccountID + ":role/a", //lintignore:AWSAT005
+			expectRequiresReplace: true,
+		},
+		"role added in provider account": {
+			planRoleARN: "arn:aws:iam::" + providerAccountID + ":role/a", //lintignore:AWSAT005
+		},
+		"role in same account": {
+			stateRoleARN: "arn:aws:iam::" + otherAccountID + ":role/a", //lintignore:AWSAT005
+			planRoleARN:  "arn:aws:iam::" + otherAccountID + ":role/b", //lintignore:AWSAT005
+		},
+	}
+
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			stateAttrs := map[string]string{names.AttrName: name}
+			if tc.stateRoleARN != "" {
+				stateAttrs[names.AttrAssumeRoleARN] = tc.stateRoleARN
+			}
+			planAttrs := map[string]string{names.AttrName: name}
+			if tc.planRoleARN != "" {
+				planAttrs[names.AttrAssumeRoleARN] = tc.planRoleARN
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: configFromSchema(ctx, s, planAttrs),
+				Plan:   planFromSchema(ctx, s, planAttrs),
+				State:  stateFromSchema(ctx, s, stateAttrs),
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+			icpt.modifyPlan(ctx, interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c:        client,
+				request:  &req,
+				response: &resp,
+				when:     Before,
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diags: %s", resp.Diagnostics)
+			}
+
+			if got, want := resp.RequiresReplace.Contains(path.Root(names.AttrAssumeRoleARN)), tc.expectRequiresReplace; got != want {
+				t.Errorf("RequiresReplace contains %q = %t, want %t", names.AttrAssumeRoleARN, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"iter"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+const (
+	// listResourceRegionsAttributeName is the name of the top-level attribute used to list resources in multiple Regions.
+	listResourceRegionsAttributeName = "regions"
+
+	// listResourceAllRegions is the "regions" value that selects all Regions enabled for the account.
+	listResourceAllRegions = "*"
+
+	// listResourceRegionsMaxConcurrency is the maximum number of Regions listed concurrently.
+	listResourceRegionsMaxConcurrency = 4
+)
+
+type listResourceInjectRegionsAttributeInterceptor struct{}
+
+func (r listResourceInjectRegionsAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[listResourceRegionsAttributeName]; !ok {
+			// Inject a top-level "regions" attribute.
+			response.Schema.Attributes[listResourceRegionsAttributeName] = listresourceattribute.Regions()
+		}
+	}
+}
+
+// listResourceInjectRegionsAttribute injects a "regions" attribute into a resource's List schema.
+func listResourceInjectRegionsAttribute() listResourceSchemaInterceptor {
+	return &listResourceInjectRegionsAttributeInterceptor{}
+}
+
+// listResourceRegions returns the Regions configured in a list request's top-level "regions" attribute.
+// "*" is expanded to all Regions enabled for the account.
+// The returned request has the attribute removed from its configuration so that inner list resources are unaware of it.
+func listResourceRegions(ctx context.Context, c *conns.AWSClient, request list.ListRequest) ([]string, list.ListRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schema, ok := request.Config.Schema.(listschema.Schema)
+	if !ok {
+		return nil, request, diags
+	}
+	if _, ok := schema.Attributes[listResourceRegionsAttributeName]; !ok {
+		return nil, request, diags
+	}
+
+	var regions []string
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		var v types.List
+		diags.Append(request.Config.GetAttribute(ctx, path.Root(listResourceRegionsAttributeName), &v)...)
+		if diags.HasError() {
+			return nil, request, diags
+		}
+		regions = fwflex.ExpandFrameworkStringValueList(ctx, v)
+	}
+
+	config, d := removeConfigAttribute(ctx, request.Config, schema, listResourceRegionsAttributeName)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, request, diags
+	}
+	request.Config = config
+
+	if slices.Contains(regions, listResourceAllRegions) {
+		var err error
+		regions, err = enabledRegions(ctx, c)
+		if err != nil {
+			diags.AddError("Listing enabled Regions", err.Error())
+			return nil, request, diags
+		}
+	}
+
+	return slices.Compact(slices.Sorted(slices.Values(regions))), request, diags
+}
+
+// removeConfigAttribute returns a copy of a list resource configuration without the specified top-level attribute.
+func removeConfigAttribute(ctx context.Context, config tfsdk.Config, schema listschema.Schema, name string) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schema.Attributes = maps.Clone(schema.Attributes)
+	delete(schema.Attributes, name)
+	typ := schema.Type().TerraformType(ctx)
+
+	var raw tftypes.Value
+	switch {
+	case config.Raw.IsNull():
+		raw = tftypes.NewValue(typ, nil)
+	case !config.Raw.IsKnown():
+		raw = tftypes.NewValue(typ, tftypes.UnknownValue)
+	default:
+		var values map[string]tftypes.Value
+		if err := config.Raw.As(&values); err != nil {
+			diags.AddError("Removing list resource configuration attribute", err.Error())
+			return config, diags
+		}
+		delete(values, name)
+		raw = tftypes.NewValue(typ, values)
+	}
+
+	return tfsdk.Config{
+		Raw:    raw,
+		Schema: schema,
+	}, diags
+}
+
+// enabledRegions returns the names of all Regions enabled for the account.
+func enabledRegions(ctx context.Context, c *conns.AWSClient) ([]string, error) {
+	var input ec2.DescribeRegionsInput
+	output, err := c.EC2Client(ctx).DescribeRegions(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output.Regions, func(v awstypes.Region) string {
+		return aws.ToString(v.RegionName)
+	}), nil
+}
+
+// listInRegions returns the merged results of listing resources in each Region.
+// At most listResourceRegionsMaxConcurrency Regions are listed concurrently and results are emitted as they arrive.
+func listInRegions(ctx context.Context, regions []string, f func(context.Context, string) iter.Seq[list.ListResult]) iter.Seq[list.ListResult] {
+	return func(yield func(list.ListResult) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := make(chan list.ListResult)
+		semaphore := make(chan struct{}, listResourceRegionsMaxConcurrency)
+
+		go func() {
+			var wg sync.WaitGroup
+			defer close(results)
+			defer wg.Wait()
+
+			for _, region := range regions {
+				select {
+				case semaphore <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					defer func() { <-semaphore }()
+
+					for result := range f(ctx, region) {
+						select {
+						case results <- result:
+						case <-ctx.Done():
+							return
+						}
+					}
+				}()
+			}
+		}()
+
+		for result := range results {
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// listInRegion returns the results of listing resources in the Region set in the context.
+func listInRegion(ctx context.Context, c *conns.AWSClient, interceptors []listInterceptorFunc[list.ListRequest, list.ListResultsStream], f func(context.Context, list.ListRequest, *list.ListResultsStream), request list.ListRequest) iter.Seq[list.ListResult] {
+	if err := c.ValidateInContextRegionInPartition(ctx); err != nil {
+		var diags diag.Diagnostics
+		diags.AddAttributeError(path.Root(listResourceRegionsAttributeName), "Invalid Region Value", err.Error())
+		return list.ListResultsStreamDiagnostics(diags)
+	}
+
+	stream := list.ListResultsStream{
+		Results: tfiter.Null[list.ListResult](),
+	}
+	interceptedListHandler(interceptors, f, c)(ctx, request, &stream)
+
+	return stream.Results
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRemoveConfigAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	schema := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrRegion:                 listresourceattribute.Region(),
+			listResourceRegionsAttributeName: listresourceattribute.Regions(),
+		},
+	}
+	configType := schema.Type().TerraformType(ctx)
+	wantType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrRegion: tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		raw  tftypes.Value
+		want tftypes.Value
+	}{
+		"null": {
+			raw:  tftypes.NewValue(configType, nil),
+			want: tftypes.NewValue(wantType, nil),
+		},
+		"unknown": {
+			raw:  tftypes.NewValue(configType, tftypes.UnknownValue),
+			want: tftypes.NewValue(wantType, tftypes.UnknownValue),
+		},
+		"known": {
+			raw: tftypes.NewValue(configType, map[string]tftypes.Value{
+				names.AttrRegion: tftypes.NewValue(tftypes.String, nil),
+				listResourceRegionsAttributeName: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "*"),
+				}),
+			}),
+			want: tftypes.NewValue(wantType, map[string]tftypes.Value{
+				names.AttrRegion: tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config{
+				Raw:    testCase.raw,
+				Schema: schema,
+			}
+
+			got, diags := removeConfigAttribute(ctx, config, schema, listResourceRegionsAttributeName)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !got.Raw.Equal(testCase.want) {
+				t.Errorf("unexpected value: got %s, want %s", got.Raw, testCase.want)
+			}
+
+			if _, ok := got.Schema.GetAttributes()[listResourceRegionsAttributeName]; ok {
+				t.Errorf("attribute %q not removed from schema", listResourceRegionsAttributeName)
+			}
+
+			if _, ok := schema.Attributes[listResourceRegionsAttributeName]; !ok {
+				t.Errorf("attribute %q removed from original schema", listResourceRegionsAttributeName)
+			}
+		})
+	}
+}
+
+func TestListInRegions(t *testing.T) {
+	t.Parallel()
+
+	regions := []string{"us-east-1", "us-west-2", "eu-west-1", "ap-southeast-1", "ap-southeast-2", "sa-east-1"}
+
+	listInRegion := func(_ context.Context, region string) iter.Seq[list.ListResult] {
+		return func(yield func(list.ListResult) bool) {
+			for _, suffix := range []string{"a", "b"} {
+				if !yield(list.ListResult{DisplayName: region + suffix}) {
+					return
+				}
+			}
+		}
+	}
+
+	t.Run("all", func(t *testing.T) {
+		t.Parallel()
+
+		var got []string
+		for result := range listInRegions(t.Context(), regions, listInRegion) {
+			got = append(got, result.DisplayName)
+		}
+		slices.Sort(got)
+
+		var want []string
+		for _, region := range regions {
+			want = append(want, region+"a", region+"b")
+		}
+		slices.Sort(want)
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		t.Parallel()
+
+		var n int
+		for range listInRegions(t.Context(), regions, listInRegion) {
+			n++
+			if n == 3 {
+				break
+			}
+		}
+
+		if n != 3 {
+			t.Errorf("got %d results, want 3", n)
+		}
+	})
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// TagFiltersBlock returns the `tag` block used to select listed resources by tag.
+// A resource matches if it matches all configured `tag` blocks.
+func TagFiltersBlock(ctx context.Context) listschema.ListNestedBlock {
+	return listschema.ListNestedBlock{
+		CustomType:  fwtypes.NewListNestedObjectTypeOf[TagFilterModel](ctx),
+		Description: "Only list resources with a tag matching the specified key and values. If multiple `tag` blocks are provided, they all must match.",
+		NestedObject: listschema.NestedBlockObject{
+			Attributes: map[string]listschema.Attribute{
+				names.AttrKey: listschema.StringAttribute{
+					Description: "Tag key.",
+					Required:    true,
+				},
+				names.AttrValues: listschema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Description: "Tag values, any of which must match. If not set, any value matches.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// NameValuesFiltersBlock returns the `filter` block used to pass name/values filters to the service API.
+// Validators are applied to each filter's `name`.
+func NameValuesFiltersBlock(ctx context.Context, nameValidators ...validator.String) listschema.ListNestedBlock {
+	return listschema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[NameValuesFilterModel](ctx),
+		NestedObject: listschema.NestedBlockObject{
+			Attributes: map[string]listschema.Attribute{
+				names.AttrName: listschema.StringAttribute{
+					Required:   true,
+					Validators: nameValidators,
+				},
+				names.AttrValues: listschema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// TagFilterModel represents a single `tag` block.
+type TagFilterModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+// NameValuesFilterModel represents a single `filter` block.
+type NameValuesFilterModel struct {
+	Name   types.String         `tfsdk:"name"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+// WithNamePrefixModel is embedded in the configuration model of list resources supporting `name_prefix`.
+type WithNamePrefixModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func (m WithNamePrefixModel) namePrefix() types.String {
+	return m.NamePrefix
+}
+
+// WithTagFiltersModel is embedded in the configuration model of list resources supporting `tag` blocks.
+type WithTagFiltersModel struct {
+	TagFilters fwtypes.ListNestedObjectValueOf[TagFilterModel] `tfsdk:"tag"`
+}
+
+func (m WithTagFiltersModel) tagFilters() fwtypes.ListNestedObjectValueOf[TagFilterModel] {
+	return m.TagFilters
+}
+
+// WithNameValuesFiltersModel is embedded in the configuration model of list resources supporting `filter` blocks.
+// The field name matches the `Filters` field of the AWS API input so that the blocks can be expanded by AutoFlex.
+type WithNameValuesFiltersModel struct {
+	Filters fwtypes.ListNestedObjectValueOf[NameValuesFilterModel] `tfsdk:"filter"`
+}
+
+// Filter selects listed resources by name prefix and tags.
+// List resources push as much of a Filter down to the service API as the API supports
+// and apply the remainder client-side with Match.
+type Filter struct {
+	NamePrefix string
+	// Tags contains one condition per `tag` block, all of which must match.
+	Tags []TagCondition
+}
+
+// TagCondition matches resources with a tag whose key is Key and whose value is any of Values.
+// Empty Values permit any value.
+type TagCondition struct {
+	Key    string
+	Values []string
+}
+
+// NewFilter returns the Filter configured by a list resource's configuration model.
+// The model supports filtering if it embeds WithNamePrefixModel or WithTagFiltersModel.
+func NewFilter(ctx context.Context, model any) (Filter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var filter Filter
+
+	if v, ok := model.(interface{ namePrefix() types.String }); ok {
+		filter.NamePrefix = v.namePrefix().ValueString()
+	}
+
+	if v, ok := model.(interface {
+		tagFilters() fwtypes.ListNestedObjectValueOf[TagFilterModel]
+	}); ok {
+		tagFilters, d := v.tagFilters().ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return filter, diags
+		}
+
+		for _, tagFilter := range tagFilters {
+			filter.Tags = append(filter.Tags, TagCondition{
+				Key:    tagFilter.Key.ValueString(),
+				Values: fwflex.ExpandFrameworkStringValueList(ctx, tagFilter.Values),
+			})
+		}
+	}
+
+	return filter, diags
+}
+
+// IsEmpty returns whether the Filter matches all resources.
+func (f Filter) IsEmpty() bool {
+	return f.NamePrefix == "" && len(f.Tags) == 0
+}
+
+// Match returns whether a resource with the specified name and tags passes the Filter.
+func (f Filter) Match(name string, tags tftags.KeyValueTags) bool {
+	return f.MatchName(name) && f.MatchTags(tags)
+}
+
+// MatchName returns whether a resource with the specified name passes the Filter's name prefix.
+func (f Filter) MatchName(name string) bool {
+	return strings.HasPrefix(name, f.NamePrefix)
+}
+
+// MatchTags returns whether a resource with the specified tags passes the Filter's tag filters.
+func (f Filter) MatchTags(tags tftags.KeyValueTags) bool {
+	for _, condition := range f.Tags {
+		if !tags.KeyExists(condition.Key) {
+			return false
+		}
+
+		if len(condition.Values) > 0 && !slices.Contains(condition.Values, tags.KeyTagData(condition.Key).ValueString()) {
+			return false
+		}
+	}
+
+	return true
+}
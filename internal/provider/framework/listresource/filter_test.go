@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestNewFilter(t *testing.T) {
+	t.Parallel()
+
+	type withoutFiltersModel struct {
+		Name types.String `tfsdk:"name"`
+	}
+
+	type withFiltersModel struct {
+		listresource.WithNamePrefixModel
+		listresource.WithTagFiltersModel
+	}
+
+	ctx := t.Context()
+
+	testCases := map[string]struct {
+		model any
+		want  listresource.Filter
+	}{
+		"no filters": {
+			model: withoutFiltersModel{
+				Name: types.StringValue("test"),
+			},
+			want: listresource.Filter{},
+		},
+		"null": {
+			model: withFiltersModel{
+				WithNamePrefixModel: listresource.WithNamePrefixModel{
+					NamePrefix: types.StringNull(),
+				},
+				WithTagFiltersModel: listresource.WithTagFiltersModel{
+					TagFilters: fwtypes.NewListNestedObjectValueOfNull[listresource.TagFilterModel](ctx),
+				},
+			},
+			want: listresource.Filter{},
+		},
+		"name prefix and tags": {
+			model: withFiltersModel{
+				WithNamePrefixModel: listresource.WithNamePrefixModel{
+					NamePrefix: types.StringValue("tf-acc-"),
+				},
+				WithTagFiltersModel: listresource.WithTagFiltersModel{
+					TagFilters: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*listresource.TagFilterModel{
+						{
+							Key:    types.StringValue("Environment"),
+							Values: fwflex.FlattenFrameworkStringValueListOfString(ctx, []string{"production", "staging"}),
+						},
+						{
+							Key:    types.StringValue("Owner"),
+							Values: fwflex.FlattenFrameworkStringValueListOfString(ctx, nil),
+						},
+					}),
+				},
+			},
+			want: listresource.Filter{
+				NamePrefix: "tf-acc-",
+				Tags: []listresource.TagCondition{
+					{Key: "Environment", Values: []string{"production", "staging"}},
+					{Key: "Owner"},
+				},
+			},
+		},
+		"tags with the same key": {
+			model: withFiltersModel{
+				WithNamePrefixModel: listresource.WithNamePrefixModel{
+					NamePrefix: types.StringNull(),
+				},
+				WithTagFiltersModel: listresource.WithTagFiltersModel{
+					TagFilters: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*listresource.TagFilterModel{
+						{
+							Key:    types.StringValue("Environment"),
+							Values: fwflex.FlattenFrameworkStringValueListOfString(ctx, []string{"production", "staging"}),
+						},
+						{
+							Key:    types.StringValue("Environment"),
+							Values: fwflex.FlattenFrameworkStringValueListOfString(ctx, []string{"staging", "development"}),
+						},
+					}),
+				},
+			},
+			want: listresource.Filter{
+				Tags: []listresource.TagCondition{
+					{Key: "Environment", Values: []string{"production", "staging"}},
+					{Key: "Environment", Values: []string{"staging", "development"}},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := listresource.NewFilter(ctx, testCase.model)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	testCases := map[string]struct {
+		filter listresource.Filter
+		name   string
+		tags   tftags.KeyValueTags
+		want   bool
+	}{
+		"empty": {
+			filter: listresource.Filter{},
+			name:   "test",
+			tags:   tftags.New(ctx, map[string]string{}),
+			want:   true,
+		},
+		"name prefix match": {
+			filter: listresource.Filter{NamePrefix: "tf-acc-"},
+			name:   "tf-acc-test",
+			tags:   tftags.New(ctx, map[string]string{}),
+			want:   true,
+		},
+		"name prefix no match": {
+			filter: listresource.Filter{NamePrefix: "tf-acc-"},
+			name:   "test",
+			tags:   tftags.New(ctx, map[string]string{}),
+			want:   false,
+		},
+		"tag key match": {
+			filter: listresource.Filter{Tags: []listresource.TagCondition{{Key: "Owner"}}},
+			tags:   tftags.New(ctx, map[string]string{"Owner": "team"}),
+			want:   true,
+		},
+		"tag key no match": {
+			filter: listresource.Filter{Tags: []listresource.TagCondition{{Key: "Owner"}}},
+			tags:   tftags.New(ctx, map[string]string{"Environment": "production"}),
+			want:   false,
+		},
+		"tag value match": {
+			filter: listresource.Filter{Tags: []listresource.TagCondition{{Key: "Environment", Values: []string{"production", "staging"}}}},
+			tags:   tftags.New(ctx, map[string]string{"Environment": "staging"}),
+			want:   true,
+		},
+		"tag value no match": {
+			filter: listresource.Filter{Tags: []listresource.TagCondition{{Key: "Environment", Values: []string{"production", "staging"}}}},
+			tags:   tftags.New(ctx, map[string]string{"Environment": "development"}),
+			want:   false,
+		},
+		"all tags must match": {
+			filter: listresource.Filter{Tags: []listresource.TagCondition{{Key: "Environment", Values: []string{"production"}}, {Key: "Owner"}}},
+			tags:   tftags.New(ctx, map[string]string{"Environment": "production"}),
+			want:   false,
+		},
+		"all tags with the same key must match": {
+			filter: listresource.Filter{Tags: []listresource.TagCondition{
+				{Key: "Environment", Values: []string{"production", "staging"}},
+				{Key: "Environment", Values: []string{"staging", "development"}},
+			}},
+			tags: tftags.New(ctx, map[string]string{"Environment": "production"}),
+			want: false,
+		},
+		"tags with the same key match": {
+			filter: listresource.Filter{Tags: []listresource.TagCondition{
+				{Key: "Environment", Values: []string{"production", "staging"}},
+				{Key: "Environment", Values: []string{"staging", "development"}},
+			}},
+			tags: tftags.New(ctx, map[string]string{"Environment": "staging"}),
+			want: true,
+		},
+		"name and tags match": {
+			filter: listresource.Filter{NamePrefix: "tf-acc-", Tags: []listresource.TagCondition{{Key: "Environment", Values: []string{"production"}}}},
+			name:   "tf-acc-test",
+			tags:   tftags.New(ctx, map[string]string{"Environment": "production", "Owner": "team"}),
+			want:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.filter.Match(testCase.name, testCase.tags), testCase.want; got != want {
+				t.Errorf("Match() = %t, want %t", got, want)
+			}
+		})
+	}
+}
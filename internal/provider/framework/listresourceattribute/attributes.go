@@ -6,7 +6,11 @@ package listresourceattribute
 import (
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -16,3 +20,22 @@ var Region = sync.OnceValue(func() schema.Attribute {
 		Description: names.ListResourceTopLevelRegionAttributeDescription,
 	}
 })
+
+var Regions = sync.OnceValue(func() schema.Attribute {
+	return schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: names.ListResourceTopLevelRegionsAttributeDescription,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ConflictsWith(path.MatchRoot(names.AttrRegion)),
+		},
+	}
+})
+
+var NamePrefix = sync.OnceValue(func() schema.Attribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Only list resources whose name begins with the specified prefix.",
+	}
+})
@@ -107,6 +107,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_audit_log_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON-lines record of each AWS API call is appended. Request and response bodies are never recorded.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -143,6 +147,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"permission_preflight": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Use IAM policy simulation during plan to warn when the caller is not allowed to make the main API calls of a planned resource change. Only resources that declare the IAM actions they need are checked.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -202,7 +210,7 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 			"tag_policy_compliance": schema.StringAttribute{
 				Optional: true,
 				Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-					`At this time this only includes compliance with required tag keys by resource type. ` +
+					`This includes compliance with required tag keys by resource type, tag key capitalization and allowed tag values. ` +
 					`Valid values are "error", "warning", and "disabled". ` +
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
@@ -225,52 +233,7 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"assume_role": schema.ListNestedBlock{
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"duration": schema.StringAttribute{
-							CustomType:  fwtypes.DurationType,
-							Optional:    true,
-							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
-						},
-						"external_id": schema.StringAttribute{
-							Optional:    true,
-							Description: "A unique identifier that might be required when you assume a role in another account.",
-						},
-						"policy": schema.StringAttribute{
-							Optional:    true,
-							Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
-						},
-						"policy_arns": schema.SetAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
-						},
-						"role_arn": schema.StringAttribute{
-							Optional:    true, // For historical reasons, we allow an empty `assume_role` block
-							Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
-						},
-						"session_name": schema.StringAttribute{
-							Optional:    true,
-							Description: "An identifier for the assumed role session.",
-						},
-						"source_identity": schema.StringAttribute{
-							Optional:    true,
-							Description: "Source identity specified by the principal assuming the role.",
-						},
-						"tags": schema.MapAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Description: "Assume role session tags.",
-						},
-						"transitive_tag_keys": schema.SetAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Description: "Assume role session tag keys to pass to any subsequent sessions.",
-						},
-					},
-				},
-			},
+			"assume_role": assumeRoleBlock(),
 			"assume_role_with_web_identity": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -315,6 +278,16 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types to which `tags` are not defaulted. Rules still apply to these resource types.",
+						},
+						"exclude_services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Services whose resources `tags` are not defaulted to. Rules still apply to these resources.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -322,6 +295,40 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration blocks with additional resource tags to default across the resources matched by resource type and service.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types to which the rule's tags are not defaulted.",
+									},
+									"exclude_services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Services whose resources the rule's tags are not defaulted to.",
+									},
+									"include_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types to which the rule's tags are defaulted.",
+									},
+									"include_services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Services whose resources the rule's tags are defaulted to.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource tags to default across the resources matched by the rule.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -345,6 +352,120 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with the " + tftags.IgnoreTagsKeysEnvVar + " environment variable.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration blocks with settings to ignore resource tags by key and value, optionally only on certain resource types.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_prefixes": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tag key prefixes to ignore.",
+									},
+									"keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tag keys to ignore.",
+									},
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types on which to ignore tags. Defaults to all resource types.",
+									},
+									"value_regex": schema.StringAttribute{
+										Optional:    true,
+										Description: "Regular expression that resource tag values to ignore must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_assume_role": schema.ListNestedBlock{
+				Description: "Configuration blocks with IAM Roles to assume for individual services' API calls instead of the provider's credentials.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, specified as a service package name or alias as used in the `endpoints` configuration block.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"assume_role": assumeRoleBlock(),
+					},
+				},
+			},
+			"service_rate_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with client-side rate limits and concurrency caps for individual services' API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests made at once before the sustained request rate applies. Defaults to 1.",
+						},
+						"max_in_flight": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent requests. If unset or 0, concurrency is not limited.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The sustained request rate. If unset or 0, the request rate is not limited.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, specified as a service package name or alias as used in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func assumeRoleBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"duration": schema.StringAttribute{
+					CustomType:  fwtypes.DurationType,
+					Optional:    true,
+					Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+				},
+				"external_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "A unique identifier that might be required when you assume a role in another account.",
+				},
+				"policy": schema.StringAttribute{
+					Optional:    true,
+					Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"policy_arns": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"role_arn": schema.StringAttribute{
+					Optional:    true, // For historical reasons, we allow an empty `assume_role` block
+					Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
+				},
+				"session_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "An identifier for the assumed role session.",
+				},
+				"source_identity": schema.StringAttribute{
+					Optional:    true,
+					Description: "Source identity specified by the principal assuming the role.",
+				},
+				"tags": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Assume role session tags.",
+				},
+				"transitive_tag_keys": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Assume role session tag keys to pass to any subsequent sessions.",
 				},
 			},
 		},
@@ -404,7 +525,26 @@ func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action
 func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNMatchesFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRSubnetsForAZsFunction,
+		tffunction.NewDNSNormalizeFunction,
+		tffunction.NewDNSPTRRecordNameFunction,
+		tffunction.NewEC2UserDataBuildFunction,
+		tffunction.NewEC2UserDataDecodeFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewReverseDNSNameFunction,
+		tffunction.NewS3ObjectChecksumFunction,
+		tffunction.NewS3ObjectETagFunction,
+		tffunction.NewScheduleExpressionNextFunction,
+		tffunction.NewScheduleExpressionValidateFunction,
+		tffunction.NewServiceEndpointFunction,
+		tffunction.NewServicePrincipalFunction,
+		tffunction.NewTagsAllFunction,
+		tffunction.NewTagsFromListFunction,
+		tffunction.NewTagsToListFunction,
+		tffunction.NewTagsURLEncodeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -550,6 +690,11 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 				continue
 			}
 
+			if err := validateSchemaAccountForResource(resourceSpec.Account, schemaResponse.Schema); err != nil {
+				errs = append(errs, fmt.Errorf("resource type %q: %w", typeName, err))
+				continue
+			}
+
 			if err := validateSchemaTagsForResource(resourceSpec.Tags, schemaResponse.Schema); err != nil {
 				errs = append(errs, fmt.Errorf("resource type %q: %w", typeName, err))
 				continue
@@ -612,6 +757,15 @@ func validateSchemaRegionForResource(regionSpec unique.Handle[inttypes.ServicePa
 	return nil
 }
 
+func validateSchemaAccountForResource(accountSpec unique.Handle[inttypes.ServicePackageResourceAccount], schema resourceschema.Schema) error {
+	if !tfunique.IsHandleNil(accountSpec) && accountSpec.Value().IsAssumeRoleOverrideEnabled {
+		if _, ok := schema.Attributes[names.AttrAssumeRoleARN]; ok {
+			return fmt.Errorf("configured for per-resource IAM Role override but defines `%s` attribute in schema", names.AttrAssumeRoleARN)
+		}
+	}
+	return nil
+}
+
 func validateSchemaTagsForDataSource(tagsSpec unique.Handle[inttypes.ServicePackageResourceTags], schema datasourceschema.Schema) error {
 	if !tfunique.IsHandleNil(tagsSpec) {
 		if v, ok := schema.Attributes[names.AttrTags]; ok {
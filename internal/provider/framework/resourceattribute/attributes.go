@@ -7,9 +7,21 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+var AssumeRoleARN = sync.OnceValue(func() schema.Attribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			fwvalidators.ARN(),
+		},
+		Description: names.ResourceTopLevelAssumeRoleARNAttributeDescription,
+	}
+})
+
 var Region = sync.OnceValue(func() schema.Attribute {
 	return schema.StringAttribute{
 		Optional:    true,
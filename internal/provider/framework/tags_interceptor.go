@@ -263,7 +263,8 @@ func resourceTransparentTagging(servicePackageResourceTags unique.Handle[inttype
 	}
 }
 
-// resourceValidateRequiredTags validates that required tags are present for a given resource type.
+// resourceValidateRequiredTags validates that a given resource type's tags comply with organizational tag policies:
+// required tags are present, tag keys are correctly capitalized and tag values are allowed.
 func resourceValidateRequiredTags() resourceModifyPlanInterceptor {
 	return &resourceValidateRequiredTagsInterceptor{}
 }
@@ -282,8 +283,8 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 	if policy == nil {
 		return
 	}
-	reqTags, ok := policy.RequiredTags[typeName]
-	if !ok {
+	reqTags, hasReqTags := policy.RequiredTags[typeName]
+	if !hasReqTags && !policy.HasTagKeyPolicies() {
 		return
 	}
 
@@ -315,21 +316,27 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 			return
 		}
 
-		if allPlanTags.ContainsAllKeys(reqTags) {
-			return
+		addDiagnostic := func(summary, detail string) {
+			switch policy.Severity {
+			case "warning":
+				opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+			default:
+				opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+			}
 		}
 
-		missing := reqTags.Removed(allPlanTags).Keys()
-		slices.Sort(missing)
+		if hasReqTags && !allPlanTags.ContainsAllKeys(reqTags) {
+			missing := reqTags.Removed(allPlanTags).Keys()
+			slices.Sort(missing)
 
-		summary := "Missing Required Tags"
-		detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+			addDiagnostic(
+				"Missing Required Tags",
+				fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing),
+			)
+		}
 
-		switch policy.Severity {
-		case "warning":
-			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
-		default:
-			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		for _, violation := range policy.Violations(typeName, allPlanTags) {
+			addDiagnostic(tftags.TagPolicyViolationSummary, violation.Detail(typeName))
 		}
 	}
 }
@@ -47,6 +47,25 @@ func (c mockRequiredTagsClient) TagPolicyConfig(ctx context.Context) *tftags.Tag
 	}
 }
 
+type mockTagKeyPoliciesClient struct {
+	mockRequiredTagsClient
+}
+
+func (c mockTagKeyPoliciesClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig {
+	return &tftags.TagPolicyConfig{
+		Severity: "warning",
+		TagKeyPolicies: []tftags.TagKeyPolicy{
+			{
+				Key:    "CostCenter",
+				Values: []string{"100"},
+				EnforcedFor: map[string]struct{}{
+					"aws_test": {},
+				},
+			},
+		},
+	}
+}
+
 type mockServicePackage struct{}
 
 func (sp mockServicePackage) FrameworkDataSources(context.Context) []*inttypes.ServicePackageFrameworkDataSource {
@@ -409,3 +428,96 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 		})
 	}
 }
+
+func Test_resourceValidateRequiredTagsInterceptor_tagKeyPolicies(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"tags": tftags.TagsAttribute(),
+		},
+	}
+	newValue := func(tags map[string]string) tftypes.Value {
+		elements := make(map[string]tftypes.Value, len(tags))
+		for k, v := range tags {
+			elements[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		return tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+			"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elements),
+		})
+	}
+
+	tests := []struct {
+		name      string
+		tags      map[string]string
+		wantDiags diag.Diagnostics
+	}{
+		{
+			name: "compliant",
+			tags: map[string]string{"CostCenter": "100"},
+		},
+		{
+			name: "noncompliant",
+			tags: map[string]string{"costcenter": "200"},
+			wantDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root(names.AttrTags),
+					"Noncompliant Tag",
+					`An organizational tag policy is not complied with by aws_test: tag "costcenter" value "200" is not one of the allowed values ["100"]. The tag policy is enforced for this resource type and AWS will reject the tagging operation.`,
+				),
+				diag.NewAttributeWarningDiagnostic(
+					path.Root(names.AttrTags),
+					"Noncompliant Tag",
+					`An organizational tag policy is not complied with by aws_test: tag key "costcenter" must be capitalized as "CostCenter". The tag policy is enforced for this resource type and AWS will reject the tagging operation.`,
+				),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := mockTagKeyPoliciesClient{}
+			ctx := conns.NewResourceContext(ctx, "Test", "test", "aws_test", "")
+			ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
+
+			raw := newValue(tt.tags)
+			opts := interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: c,
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    raw,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    raw,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    raw,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			}
+
+			resourceValidateRequiredTags().modifyPlan(ctx, opts)
+
+			if !opts.response.Diagnostics.Equal(tt.wantDiags) {
+				t.Errorf("response diagnostics not equal. got: %s want: %s", opts.response.Diagnostics, tt.wantDiags)
+			}
+		})
+	}
+}
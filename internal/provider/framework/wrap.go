@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"iter"
 
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -544,6 +545,11 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		}
 	}
 
+	if v := spec.Account; !tfunique.IsHandleNil(v) && v.Value().IsAssumeRoleOverrideEnabled {
+		interceptors = append(interceptors, resourceInjectAssumeRoleARNAttribute())
+		interceptors = append(interceptors, resourceForceNewIfAssumeRoleARNChanges())
+	}
+
 	if !tfunique.IsHandleNil(spec.Tags) {
 		interceptors = append(interceptors, resourceTransparentTagging(spec.Tags))
 		interceptors = append(interceptors, resourceValidateRequiredTags())
@@ -583,6 +589,23 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 	}
 }
 
+// resourceID returns the value of the resource's `id` attribute or, if it has none, of its ARN identity attribute.
+// An empty string is returned if the value is not known.
+func (w *wrappedResource) resourceID(ctx context.Context, getAttribute getAttributeFunc) string {
+	for _, name := range []string{names.AttrID, w.spec.Identity.IdentityAttribute} {
+		if name == "" {
+			continue
+		}
+
+		var target types.String
+		if diags := getAttribute(ctx, path.Root(name), &target); !diags.HasError() && target.ValueString() != "" {
+			return target.ValueString()
+		}
+	}
+
+	return ""
+}
+
 // context is run on all wrapped methods before any interceptors.
 func (w *wrappedResource) context(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -604,6 +627,28 @@ func (w *wrappedResource) context(ctx context.Context, getAttribute getAttribute
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	if getAttribute != nil {
+		ctx = conns.WithResourceID(ctx, w.resourceID(ctx, getAttribute))
+	}
+
+	if v := w.spec.Account; !tfunique.IsHandleNil(v) && v.Value().IsAssumeRoleOverrideEnabled && getAttribute != nil {
+		var target types.String
+		diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if roleARN := target.ValueString(); roleARN != "" {
+			if c != nil {
+				if err := c.VerifyAssumeRoleARNAllowed(roleARN); err != nil {
+					diags.AddError("Verifying assume_role_arn AWS account", err.Error())
+					return ctx, diags
+				}
+			}
+			ctx = conns.WithOverrideAssumeRoleARN(ctx, roleARN)
+		}
+	}
+
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -829,6 +874,7 @@ func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListR
 
 	if isRegionOverrideEnabled {
 		interceptors = append(interceptors, listResourceInjectRegionAttribute())
+		interceptors = append(interceptors, listResourceInjectRegionsAttribute())
 		// TODO: validate region in partition, needs tweaked error message
 	}
 
@@ -882,6 +928,11 @@ func (w *wrappedListResourceFramework) context(ctx context.Context, getAttribute
 		}
 	}
 
+	return w.regionalContext(ctx, overrideRegion, c), diags
+}
+
+// regionalContext returns the context used for requests to the specified Region.
+func (w *wrappedListResourceFramework) regionalContext(ctx context.Context, overrideRegion string, c *conns.AWSClient) context.Context {
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
@@ -889,7 +940,7 @@ func (w *wrappedListResourceFramework) context(ctx context.Context, getAttribute
 		ctx = fwflex.RegisterLogger(ctx)
 	}
 
-	return ctx, diags
+	return ctx
 }
 
 func (w *wrappedListResourceFramework) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
@@ -909,7 +960,22 @@ func (w *wrappedListResourceFramework) Configure(ctx context.Context, request re
 func (w *wrappedListResourceFramework) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
 	stream.Results = tfiter.Null[list.ListResult]()
 
-	ctx, diags := w.context(ctx, request.Config.GetAttribute, w.meta)
+	regions, request, diags := listResourceRegions(ctx, w.meta, request)
+	if len(diags) > 0 {
+		stream.Results = tfiter.Concat(stream.Results, list.ListResultsStreamDiagnostics(diags))
+	}
+	if diags.HasError() {
+		return
+	}
+
+	if len(regions) > 0 {
+		stream.Results = tfiter.Concat(stream.Results, listInRegions(ctx, regions, func(ctx context.Context, region string) iter.Seq[list.ListResult] {
+			return listInRegion(w.regionalContext(ctx, region, w.meta), w.meta, w.interceptors.resourceList(), w.inner.List, request)
+		}))
+		return
+	}
+
+	ctx, diags = w.context(ctx, request.Config.GetAttribute, w.meta)
 	if len(diags) > 0 {
 		stream.Results = tfiter.Concat(stream.Results, list.ListResultsStreamDiagnostics(diags))
 	}
@@ -952,6 +1018,7 @@ func newWrappedListResourceSDK(spec *inttypes.ServicePackageSDKListResource, ser
 
 	if v := spec.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
 		interceptors = append(interceptors, listResourceInjectRegionAttribute())
+		interceptors = append(interceptors, listResourceInjectRegionsAttribute())
 		// TODO: validate region in partition, needs tweaked error message
 	}
 
@@ -1009,6 +1076,11 @@ func (w *wrappedListResourceSDK) context(ctx context.Context, getAttribute getAt
 		}
 	}
 
+	return w.regionalContext(ctx, overrideRegion, c), diags
+}
+
+// regionalContext returns the context used for requests to the specified Region.
+func (w *wrappedListResourceSDK) regionalContext(ctx context.Context, overrideRegion string, c *conns.AWSClient) context.Context {
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
@@ -1016,7 +1088,7 @@ func (w *wrappedListResourceSDK) context(ctx context.Context, getAttribute getAt
 		ctx = fwflex.RegisterLogger(ctx)
 	}
 
-	return ctx, diags
+	return ctx
 }
 
 func (w *wrappedListResourceSDK) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
@@ -1036,7 +1108,22 @@ func (w *wrappedListResourceSDK) Configure(ctx context.Context, request resource
 func (w *wrappedListResourceSDK) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
 	stream.Results = tfiter.Null[list.ListResult]()
 
-	ctx, diags := w.context(ctx, request.Config.GetAttribute, w.meta)
+	regions, request, diags := listResourceRegions(ctx, w.meta, request)
+	if len(diags) > 0 {
+		stream.Results = tfiter.Concat(stream.Results, list.ListResultsStreamDiagnostics(diags))
+	}
+	if diags.HasError() {
+		return
+	}
+
+	if len(regions) > 0 {
+		stream.Results = tfiter.Concat(stream.Results, listInRegions(ctx, regions, func(ctx context.Context, region string) iter.Seq[list.ListResult] {
+			return listInRegion(w.regionalContext(ctx, region, w.meta), w.meta, w.interceptors.resourceList(), w.inner.List, request)
+		}))
+		return
+	}
+
+	ctx, diags = w.context(ctx, request.Config.GetAttribute, w.meta)
 	if len(diags) > 0 {
 		stream.Results = tfiter.Concat(stream.Results, list.ListResultsStreamDiagnostics(diags))
 	}
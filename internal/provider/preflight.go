@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type preflightResource struct {
+	servicePackageName string
+	resourceName       string
+	permissions        inttypes.ServicePackageResourcePermissions
+	valueType          tftypes.Type
+}
+
+// permissionPreflightServer wraps a provider server and, when permission preflight is enabled,
+// checks that the caller is allowed to make the API calls needed to apply each planned resource change.
+type permissionPreflightServer struct {
+	tfprotov5.ProviderServer
+
+	primary *schema.Provider
+
+	resourcesOnce sync.Once
+	resources     map[string]preflightResource
+}
+
+func newPermissionPreflightServer(server tfprotov5.ProviderServer, primary *schema.Provider) tfprotov5.ProviderServer {
+	return &permissionPreflightServer{
+		ProviderServer: server,
+		primary:        primary,
+	}
+}
+
+func (s *permissionPreflightServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || hasErrorDiagnostic(response.Diagnostics) {
+		return response, err
+	}
+
+	c, ok := s.primary.Meta().(*conns.AWSClient)
+	if !ok || !c.PermissionPreflight(ctx) {
+		return response, nil
+	}
+
+	resource, ok := s.preflightResources(ctx, c)[request.TypeName]
+	if !ok {
+		return response, nil
+	}
+
+	prior, err := unmarshalDynamicValue(request.PriorState, resource.valueType)
+	if err != nil {
+		return response, nil //nolint:nilerr // Preflight errors are never fatal.
+	}
+	planned, err := unmarshalDynamicValue(response.PlannedState, resource.valueType)
+	if err != nil {
+		return response, nil //nolint:nilerr // Preflight errors are never fatal.
+	}
+
+	requiresReplace := len(response.RequiresReplace) > 0
+	if !isPlannedChange(prior, planned, requiresReplace) {
+		return response, nil
+	}
+
+	actions := preflightActions(resource.permissions, prior, planned, requiresReplace)
+	if len(actions) == 0 {
+		tflog.Debug(ctx, "Resource does not declare the IAM actions needed to apply the planned change, not checking API permissions", map[string]any{
+			"resource": request.TypeName,
+		})
+		return response, nil
+	}
+
+	ctx = conns.NewResourceContext(ctx, resource.servicePackageName, resource.resourceName, request.TypeName, "")
+	if roleARN := stringAttribute(planned, names.AttrAssumeRoleARN); roleARN != "" {
+		ctx = conns.WithOverrideAssumeRoleARN(ctx, roleARN)
+	} else if roleARN := stringAttribute(prior, names.AttrAssumeRoleARN); roleARN != "" {
+		ctx = conns.WithOverrideAssumeRoleARN(ctx, roleARN)
+	}
+
+	resourceARN := stringAttribute(planned, names.AttrARN)
+	if resourceARN == "" {
+		resourceARN = stringAttribute(prior, names.AttrARN)
+	}
+
+	denied, err := c.DeniedActions(ctx, actions, resourceARN)
+
+	switch {
+	case err != nil:
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Unable to check API permissions",
+			Detail:   fmt.Sprintf("The permissions needed to apply changes to this %s resource could not be checked: %s", request.TypeName, err),
+		})
+	case len(denied) > 0:
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Missing API permissions",
+			Detail:   fmt.Sprintf("Applying changes to this %s resource is likely to fail. The caller is not allowed to perform the following actions: %s", request.TypeName, strings.Join(denied, ", ")),
+		})
+	}
+
+	return response, nil
+}
+
+// preflightResources returns all resources, keyed by type name, along with any API permissions they declare.
+func (s *permissionPreflightServer) preflightResources(ctx context.Context, c *conns.AWSClient) map[string]preflightResource {
+	s.resourcesOnce.Do(func() {
+		s.resources = make(map[string]preflightResource)
+
+		for sp := range c.ServicePackages(ctx) {
+			servicePackageName := sp.ServicePackageName()
+
+			for _, v := range sp.SDKResources(ctx) {
+				s.resources[v.TypeName] = preflightResource{
+					servicePackageName: servicePackageName,
+					resourceName:       v.Name,
+					permissions:        v.Permissions,
+				}
+			}
+
+			for _, v := range sp.FrameworkResources(ctx) {
+				s.resources[v.TypeName] = preflightResource{
+					servicePackageName: servicePackageName,
+					resourceName:       v.Name,
+					permissions:        v.Permissions,
+				}
+			}
+		}
+
+		if len(s.resources) == 0 {
+			return
+		}
+
+		response, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil || hasErrorDiagnostic(response.Diagnostics) {
+			tflog.Warn(ctx, "Unable to read resource schemas, disabling permission preflight")
+			s.resources = nil
+			return
+		}
+
+		for typeName, resource := range s.resources {
+			if v, ok := response.ResourceSchemas[typeName]; ok {
+				resource.valueType = v.ValueType()
+				s.resources[typeName] = resource
+			} else {
+				delete(s.resources, typeName)
+			}
+		}
+	})
+
+	return s.resources
+}
+
+// isPlannedChange returns whether the planned change from prior to planned state calls any Create, Update or Delete API.
+func isPlannedChange(prior, planned tftypes.Value, requiresReplace bool) bool {
+	return requiresReplace || !prior.Equal(planned)
+}
+
+// preflightActions returns the IAM actions needed to apply the planned change from prior to planned state.
+func preflightActions(permissions inttypes.ServicePackageResourcePermissions, prior, planned tftypes.Value, requiresReplace bool) []string {
+	var actions []string
+
+	switch {
+	case prior.IsNull() && planned.IsNull():
+	case prior.IsNull():
+		actions = append(actions, permissions.Create...)
+	case planned.IsNull():
+		actions = append(actions, permissions.Delete...)
+	case requiresReplace:
+		actions = append(actions, permissions.Create...)
+		actions = append(actions, permissions.Delete...)
+	case !prior.Equal(planned):
+		actions = append(actions, permissions.Update...)
+	}
+
+	slices.Sort(actions)
+
+	return slices.Compact(actions)
+}
+
+func hasErrorDiagnostic(diags []*tfprotov5.Diagnostic) bool {
+	return slices.ContainsFunc(diags, func(v *tfprotov5.Diagnostic) bool {
+		return v != nil && v.Severity == tfprotov5.DiagnosticSeverityError
+	})
+}
+
+func unmarshalDynamicValue(v *tfprotov5.DynamicValue, typ tftypes.Type) (tftypes.Value, error) {
+	if v == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	return v.Unmarshal(typ)
+}
+
+// stringAttribute returns the value of the specified known, non-null top-level string attribute.
+func stringAttribute(v tftypes.Value, name string) string {
+	if !v.IsKnown() || v.IsNull() {
+		return ""
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return ""
+	}
+
+	attribute, ok := attributes[name]
+	if !ok || !attribute.IsKnown() || attribute.IsNull() {
+		return ""
+	}
+
+	var s string
+	if err := attribute.As(&s); err != nil {
+		return ""
+	}
+
+	return s
+}
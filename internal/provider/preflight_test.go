@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestPreflightActions(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+	newValue := func(name string) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+	null := tftypes.NewValue(typ, nil)
+	permissions := inttypes.ServicePackageResourcePermissions{
+		Create: []string{"svc:Create", "svc:Tag"},
+		Update: []string{"svc:Update", "svc:Tag", "svc:Untag"},
+		Delete: []string{"svc:Delete"},
+	}
+
+	testCases := map[string]struct {
+		prior, planned  tftypes.Value
+		requiresReplace bool
+		want            []string
+	}{
+		"create": {
+			prior:   null,
+			planned: newValue("a"),
+			want:    []string{"svc:Create", "svc:Tag"},
+		},
+		"update": {
+			prior:   newValue("a"),
+			planned: newValue("b"),
+			want:    []string{"svc:Tag", "svc:Untag", "svc:Update"},
+		},
+		"replace": {
+			prior:           newValue("a"),
+			planned:         newValue("b"),
+			requiresReplace: true,
+			want:            []string{"svc:Create", "svc:Delete", "svc:Tag"},
+		},
+		"delete": {
+			prior:   newValue("a"),
+			planned: null,
+			want:    []string{"svc:Delete"},
+		},
+		"no change": {
+			prior:   newValue("a"),
+			planned: newValue("a"),
+		},
+		"unknown": {
+			prior: newValue("a"),
+			planned: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			want: []string{"svc:Tag", "svc:Untag", "svc:Update"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := preflightActions(permissions, testCase.prior, testCase.planned, testCase.requiresReplace)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPlannedChange(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+	newValue := func(name string) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+	null := tftypes.NewValue(typ, nil)
+
+	testCases := map[string]struct {
+		prior, planned  tftypes.Value
+		requiresReplace bool
+		want            bool
+	}{
+		"create": {
+			prior:   null,
+			planned: newValue("a"),
+			want:    true,
+		},
+		"update": {
+			prior:   newValue("a"),
+			planned: newValue("b"),
+			want:    true,
+		},
+		"replace": {
+			prior:           newValue("a"),
+			planned:         newValue("a"),
+			requiresReplace: true,
+			want:            true,
+		},
+		"delete": {
+			prior:   newValue("a"),
+			planned: null,
+			want:    true,
+		},
+		"no change": {
+			prior:   newValue("a"),
+			planned: newValue("a"),
+		},
+		"no resource": {
+			prior:   null,
+			planned: null,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isPlannedChange(testCase.prior, testCase.planned, testCase.requiresReplace), testCase.want; got != want {
+				t.Errorf("isPlannedChange() = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func forceNewIfAssumeRoleARNChanges() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				// Force resource replacement if the value of the top-level `assume_role_arn` attribute changes the resource's AWS account.
+				if d.Id() != "" && d.HasChange(names.AttrAssumeRoleARN) {
+					o, n := d.GetChange(names.AttrAssumeRoleARN)
+					if d.NewValueKnown(names.AttrAssumeRoleARN) && conns.AssumeRoleARNAccountID(ctx, c, o.(string)) == conns.AssumeRoleARNAccountID(ctx, c, n.(string)) {
+						return nil
+					}
+					return d.ForceNew(names.AttrAssumeRoleARN)
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestForceNewIfAssumeRoleARNChanges(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerAccountID = "123456789012"
+		otherAccountID    = "210987654321"
+	)
+
+	client := mockClient{
+		accountID: providerAccountID,
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	contextFunc := func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, error) {
+		return ctx, nil
+	}
+
+	testcases := map[string]struct {
+		oldRoleARN         string
+		newRoleARN         string
+		expectedRequireNew bool
+	}{
+		"role in other account": {
+			oldRoleARN:         "arn:aws:iam::" + otherAccountID + ":role/a",    //lintignore:AWSAT005
+			newRoleARN:         "arn:aws:iam::" + providerAccountID + ":role/a", //lintignore:AWSAT005
+			expectedRequireNew: true,
+		},
+		"role added in other account": {
+			newRoleARN:         "arn:aws:iam::" + otherAccountID + ":role/a", //lintignore:AWSAT005
+			expectedRequireNew: true,
+		},
+		"role removed from other account": {
+			oldRoleARN:         "arn:aws:iam::" + otherAccountID + ":role/a", //lintignore:AWSAT005
+			expectedRequireNew: true,
+		},
+		"role added in provider account": {
+			newRoleARN:         "arn:aws:iam::" + providerAccountID + ":role/a", //lintignore:AWSAT005
+			expectedRequireNew: false,
+		},
+		"role in same account": {
+			oldRoleARN:         "arn:aws:iam::" + otherAccountID + ":role/a", //lintignore:AWSAT005
+			newRoleARN:         "arn:aws:iam::" + otherAccountID + ":role/b", //lintignore:AWSAT005
+			expectedRequireNew: false,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			interceptors := interceptorInvocations{
+				{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: forceNewIfAssumeRoleARNChanges(),
+				},
+			}
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					names.AttrAssumeRoleARN: attribute.AssumeRoleARN(),
+				},
+				CustomizeDiff: interceptedCustomizeDiffHandler(contextFunc, interceptors, nil),
+			}
+
+			state := &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					names.AttrID:   "test",
+					names.AttrName: "test",
+				},
+			}
+			if tc.oldRoleARN != "" {
+				state.Attributes[names.AttrAssumeRoleARN] = tc.oldRoleARN
+			}
+
+			raw := map[string]any{
+				names.AttrName: "test",
+			}
+			if tc.newRoleARN != "" {
+				raw[names.AttrAssumeRoleARN] = tc.newRoleARN
+			}
+
+			diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), client)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := diff.RequiresNew(), tc.expectedRequireNew; got != want {
+				t.Errorf("RequiresNew() = %t, want %t", got, want)
+			}
+		})
+	}
+}
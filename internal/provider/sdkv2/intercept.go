@@ -144,6 +144,7 @@ func interceptedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any)
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+		ctx = conns.WithResourceID(ctx, rd.Id())
 
 		var interceptors []crudInterceptorInvocation
 		for _, v := range interceptorInvocations.why(why) {
@@ -209,6 +210,7 @@ func interceptedCustomizeDiffHandler(bootstrapContext contextFunc, interceptorIn
 		if err != nil {
 			return err
 		}
+		ctx = conns.WithResourceID(ctx, d.Id())
 
 		why := CustomizeDiff
 
@@ -284,6 +286,7 @@ func interceptedImportHandler(bootstrapContext contextFunc, interceptorInvocatio
 		if err != nil {
 			return nil, err
 		}
+		ctx = conns.WithResourceID(ctx, d.Id())
 
 		why := Import
 
@@ -7,9 +7,19 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+var AssumeRoleARN = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: verify.ValidARN,
+		Description:  names.ResourceTopLevelAssumeRoleARNAttributeDescription,
+	}
+})
+
 var Region = sync.OnceValue(func() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeString,
@@ -11,6 +11,7 @@ import (
 	"log"
 	"maps"
 	"os"
+	"regexp"
 	"slices"
 	"strings"
 	"time"
@@ -67,6 +68,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:      true,
 					ConflictsWith: []string{"forbidden_account_ids"},
 				},
+				"api_audit_log_path": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Path of a file to which a JSON-lines record of each AWS API call is appended. " +
+						"Request and response bodies are never recorded.",
+				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 				"custom_ca_bundle": {
@@ -83,6 +90,18 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Configuration block with settings to default resource tags across all resources.",
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
+							"exclude_resource_types": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Resource types to which `tags` are not defaulted. Rules still apply to these resource types.",
+							},
+							"exclude_services": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Services whose resources `tags` are not defaulted to. Rules still apply to these resources.",
+							},
 							"tags": {
 								Type:     schema.TypeMap,
 								Optional: true,
@@ -90,6 +109,45 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tags to default across all resources. " +
 									"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 							},
+							"rule": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Configuration blocks with additional resource tags to default across the resources matched by resource type and service.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"exclude_resource_types": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource types to which the rule's tags are not defaulted.",
+										},
+										"exclude_services": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Services whose resources the rule's tags are not defaulted to.",
+										},
+										"include_resource_types": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource types to which the rule's tags are defaulted.",
+										},
+										"include_services": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Services whose resources the rule's tags are defaulted to.",
+										},
+										"tags": {
+											Type:        schema.TypeMap,
+											Required:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource tags to default across the resources matched by the rule.",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -145,6 +203,39 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tag key prefixes to ignore across all resources. " +
 									"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 							},
+							"rule": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Configuration blocks with settings to ignore resource tags by key and value, optionally only on certain resource types.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"key_prefixes": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource tag key prefixes to ignore.",
+										},
+										"keys": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource tag keys to ignore.",
+										},
+										"resource_types": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource types on which to ignore tags. Defaults to all resource types.",
+										},
+										"value_regex": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringIsValidRegExp,
+											Description:  "Regular expression that resource tag values to ignore must match.",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -167,6 +258,13 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 						"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 				},
+				"permission_preflight": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Use IAM policy simulation during plan to warn when the caller is not allowed " +
+						"to make the main API calls of a planned resource change. Only resources that declare the IAM actions " +
+						"they need are checked.",
+				},
 				"profile": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -206,6 +304,8 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The secret key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"service_assume_role": serviceAssumeRoleSchema(),
+				"service_rate_limits": serviceRateLimitsSchema(),
 				"shared_config_files": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -253,7 +353,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-						`At this time this only includes compliance with required tag keys by resource type. ` +
+						`This includes compliance with required tag keys by resource type, tag key capitalization and allowed tag values. ` +
 						`Valid values are "error", "warning", and "disabled". ` +
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
@@ -344,12 +444,14 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APIAuditLogPath:                d.Get("api_audit_log_path").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
+		PermissionPreflight:            d.Get("permission_preflight").(bool),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
@@ -436,7 +538,41 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]any)[0].(map[string]any))
+		tfMap := v.([]any)[0].(map[string]any)
+		config.DefaultTagsConfig = expandDefaultTags(ctx, tfMap)
+
+		var excludeResourceTypes, excludeServices []string
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+			excludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["exclude_services"].(*schema.Set); ok {
+			var dx diag.Diagnostics
+			excludeServices, dx = expandServicePackageNames(cty.GetAttrPath("default_tags").IndexInt(0).GetAttr("exclude_services"), v)
+			diags = append(diags, dx...)
+			if dx.HasError() {
+				return nil, diags
+			}
+		}
+		if len(excludeResourceTypes) > 0 || len(excludeServices) > 0 {
+			if config.DefaultTagsConfig == nil {
+				config.DefaultTagsConfig = &tftags.DefaultConfig{}
+			}
+			config.DefaultTagsConfig.ExcludeResourceTypes = excludeResourceTypes
+			config.DefaultTagsConfig.ExcludeServices = excludeServices
+		}
+
+		if v, ok := tfMap["rule"].([]any); ok && len(v) > 0 {
+			rules, dx := expandDefaultTagsRules(ctx, cty.GetAttrPath("default_tags").IndexInt(0).GetAttr("rule"), v)
+			diags = append(diags, dx...)
+			if dx.HasError() {
+				return nil, diags
+			}
+
+			if config.DefaultTagsConfig == nil {
+				config.DefaultTagsConfig = &tftags.DefaultConfig{}
+			}
+			config.DefaultTagsConfig.Rules = rules
+		}
 	} else {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
@@ -469,7 +605,21 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]any)[0].(map[string]any))
+		tfMap := v.([]any)[0].(map[string]any)
+		config.IgnoreTagsConfig = expandIgnoreTags(ctx, tfMap)
+
+		if v, ok := tfMap["rule"].([]any); ok && len(v) > 0 {
+			rules, dx := expandIgnoreTagsRules(cty.GetAttrPath("ignore_tags").IndexInt(0).GetAttr("rule"), v)
+			diags = append(diags, dx...)
+			if dx.HasError() {
+				return nil, diags
+			}
+
+			if config.IgnoreTagsConfig == nil {
+				config.IgnoreTagsConfig = &tftags.IgnoreConfig{}
+			}
+			config.IgnoreTagsConfig.Rules = rules
+		}
 	} else {
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
@@ -485,6 +635,24 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_assume_role"); ok && len(v.([]any)) > 0 {
+		roles, dg := expandServiceAssumeRoles(ctx, cty.GetAttrPath("service_assume_role"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.ServiceAssumeRoles = roles
+	}
+
+	if v, ok := d.GetOk("service_rate_limits"); ok && len(v.([]any)) > 0 {
+		limits, dg := expandServiceRateLimits(ctx, cty.GetAttrPath("service_rate_limits"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.ServiceRateLimits = limits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -708,6 +876,43 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				}
 			}
 
+			var isAssumeRoleOverrideEnabled bool
+			if v := resource.Account; !tfunique.IsHandleNil(v) && v.Value().IsAssumeRoleOverrideEnabled {
+				isAssumeRoleOverrideEnabled = true
+			}
+
+			if isAssumeRoleOverrideEnabled {
+				s := r.SchemaMap()
+
+				if _, ok := s[names.AttrAssumeRoleARN]; !ok {
+					// Inject a top-level "assume_role_arn" attribute.
+					assumeRoleARNSchema := attribute.AssumeRoleARN()
+
+					// If the resource defines no Update handler then add a stub to fake out 'Provider.Validate'.
+					if r.UpdateWithoutTimeout == nil {
+						r.UpdateWithoutTimeout = schema.NoopContext
+					}
+
+					if f := r.SchemaFunc; f != nil {
+						r.SchemaFunc = func() map[string]*schema.Schema {
+							s := f()
+							s[names.AttrAssumeRoleARN] = assumeRoleARNSchema
+							return s
+						}
+					} else {
+						r.Schema[names.AttrAssumeRoleARN] = assumeRoleARNSchema
+					}
+				}
+
+				// As with "region", the injected "assume_role_arn" attribute isn't ForceNew.
+				// Replacement is forced only if the effective AWS account changes.
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: forceNewIfAssumeRoleARNChanges(),
+				})
+			}
+
 			if !tfunique.IsHandleNil(resource.Tags) {
 				interceptors = append(interceptors, interceptorInvocation{
 					when:        Before | After | Finally,
@@ -773,6 +978,16 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					}
 
 					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion)
+					if isAssumeRoleOverrideEnabled && getAttribute != nil {
+						if roleARN, ok := getAttribute(names.AttrAssumeRoleARN); ok && roleARN != nil {
+							if c, ok := meta.(*conns.AWSClient); ok && roleARN.(string) != "" {
+								if err := c.VerifyAssumeRoleARNAllowed(roleARN.(string)); err != nil {
+									return ctx, err
+								}
+							}
+							ctx = conns.WithOverrideAssumeRoleARN(ctx, roleARN.(string))
+						}
+					}
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -835,6 +1050,13 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 				}
 			}
 
+			if v := resource.Account; !tfunique.IsHandleNil(v) && v.Value().IsAssumeRoleOverrideEnabled {
+				if _, ok := s[names.AttrAssumeRoleARN]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrAssumeRoleARN, typeName))
+					continue
+				}
+			}
+
 			if !tfunique.IsHandleNil(resource.Tags) {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -1106,6 +1328,147 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]any) *a
 	return &assumeRole
 }
 
+func serviceRateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with client-side rate limits and concurrency caps for individual services' API calls.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The maximum number of requests made at once before the sustained request rate applies. Defaults to 1.",
+				},
+				"max_in_flight": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The maximum number of concurrent requests. If unset or 0, concurrency is not limited.",
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  "The sustained request rate. If unset or 0, the request rate is not limited.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service, specified as a service package name or alias as used in the `endpoints` configuration block.",
+				},
+			},
+		},
+	}
+}
+
+func expandServiceRateLimits(_ context.Context, path cty.Path, tfList []any) (map[string]conns.ServiceRateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	limits := make(map[string]conns.ServiceRateLimit, len(tfList))
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := path.IndexInt(i)
+		service := tfMap["service"].(string)
+		servicePackageName, ok := servicePackageNameForService(service)
+		if !ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("service"), "Unsupported service %q", service))
+			continue
+		}
+
+		if _, ok := limits[servicePackageName]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("service"), "Duplicate rate limit for service %q", servicePackageName))
+			continue
+		}
+
+		limits[servicePackageName] = conns.ServiceRateLimit{
+			Burst:             tfMap["burst"].(int),
+			MaxInFlight:       tfMap["max_in_flight"].(int),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+		}
+	}
+
+	return limits, diags
+}
+
+func serviceAssumeRoleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with IAM Roles to assume for individual services' API calls instead of the provider's credentials.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"assume_role": assumeRoleSchema(),
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service, specified as a service package name or alias as used in the `endpoints` configuration block.",
+				},
+			},
+		},
+	}
+}
+
+func expandServiceAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (map[string][]awsbase.AssumeRole, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	roles := make(map[string][]awsbase.AssumeRole, len(tfList))
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := path.IndexInt(i)
+		service := tfMap["service"].(string)
+		servicePackageName, ok := servicePackageNameForService(service)
+		if !ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("service"), "Unsupported service %q", service))
+			continue
+		}
+
+		if _, ok := roles[servicePackageName]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("service"), "Duplicate IAM Role for service %q", servicePackageName))
+			continue
+		}
+
+		v, _ := tfMap["assume_role"].([]any)
+		if len(v) == 0 {
+			diags = append(diags, errs.NewAttributeRequiredError(path, "assume_role"))
+			continue
+		}
+
+		ar, d := expandAssumeRoles(ctx, path.GetAttr("assume_role"), v)
+		diags = append(diags, d...)
+		if d.HasError() {
+			continue
+		}
+
+		roles[servicePackageName] = ar
+	}
+
+	return roles, diags
+}
+
+// servicePackageNameForService returns the service package name for the specified service package name or alias.
+func servicePackageNameForService(service string) (string, bool) {
+	if slices.Contains(names.ProviderPackages(), service) {
+		return service, true
+	}
+
+	servicePackageName, err := names.ProviderPackageForAlias(service)
+	if err != nil {
+		return "", false
+	}
+
+	return servicePackageName, true
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.DefaultConfig {
 	tags := make(map[string]any)
 	for _, ev := range os.Environ() {
@@ -1128,6 +1491,68 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 	return nil
 }
 
+func expandDefaultTagsRules(ctx context.Context, path cty.Path, tfList []any) ([]tftags.DefaultTagsRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules := make([]tftags.DefaultTagsRule, 0, len(tfList))
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := path.IndexInt(i)
+		rule := tftags.DefaultTagsRule{
+			Tags: tftags.New(ctx, tfMap["tags"].(map[string]any)),
+		}
+
+		if v, ok := tfMap["include_resource_types"].(*schema.Set); ok {
+			rule.IncludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+			rule.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		for _, v := range []struct {
+			attr     string
+			services *[]string
+		}{
+			{"include_services", &rule.IncludeServices},
+			{"exclude_services", &rule.ExcludeServices},
+		} {
+			set, ok := tfMap[v.attr].(*schema.Set)
+			if !ok {
+				continue
+			}
+
+			servicePackageNames, dx := expandServicePackageNames(path.GetAttr(v.attr), set)
+			diags = append(diags, dx...)
+			*v.services = servicePackageNames
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, diags
+}
+
+// expandServicePackageNames returns the service package names for the specified set of service names.
+func expandServicePackageNames(path cty.Path, set *schema.Set) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var servicePackageNames []string
+
+	for _, service := range flex.ExpandStringValueSet(set) {
+		servicePackageName, ok := servicePackageNameForService(service)
+		if !ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Unsupported service %q", service))
+			continue
+		}
+		servicePackageNames = append(servicePackageNames, servicePackageName)
+	}
+
+	return servicePackageNames, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
 
@@ -1176,6 +1601,48 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandIgnoreTagsRules(path cty.Path, tfList []any) ([]tftags.IgnoreTagsRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules := make([]tftags.IgnoreTagsRule, 0, len(tfList))
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := path.IndexInt(i)
+		var rule tftags.IgnoreTagsRule
+
+		if v, ok := tfMap["keys"].(*schema.Set); ok {
+			rule.Keys = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
+			rule.KeyPrefixes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+			rule.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["value_regex"].(string); ok && v != "" {
+			re, err := regexp.Compile(v)
+			if err != nil {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("value_regex"), "Invalid regular expression: %s", err))
+				continue
+			}
+			rule.ValueRegex = re
+		}
+
+		if len(rule.Keys) == 0 && len(rule.KeyPrefixes) == 0 && rule.ValueRegex == nil {
+			diags = append(diags, errs.NewAtLeastOneOfChildrenError(path, path.GetAttr("keys"), path.GetAttr("key_prefixes"), path.GetAttr("value_regex")))
+			continue
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, diags
+}
+
 func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
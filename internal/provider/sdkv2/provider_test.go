@@ -5,13 +5,17 @@ package sdkv2
 
 import (
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -309,6 +313,434 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("service_assume_role")
+	testcases := map[string]struct {
+		tfList        []any
+		expectedRoles map[string][]awsbase.AssumeRole
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfList:        []any{},
+			expectedRoles: map[string][]awsbase.AssumeRole{},
+		},
+		"chained": {
+			tfList: []any{
+				map[string]any{
+					"service": "route53",
+					"assume_role": []any{
+						map[string]any{
+							"role_arn":     "arn:aws:iam::111111111111:role/network", //lintignore:AWSAT005
+							"session_name": "network",
+						},
+						map[string]any{
+							"role_arn": "arn:aws:iam::222222222222:role/dns", //lintignore:AWSAT005
+						},
+					},
+				},
+			},
+			expectedRoles: map[string][]awsbase.AssumeRole{
+				names.Route53: {
+					{
+						RoleARN:     "arn:aws:iam::111111111111:role/network", //lintignore:AWSAT005
+						SessionName: "network",
+					},
+					{
+						RoleARN: "arn:aws:iam::222222222222:role/dns", //lintignore:AWSAT005
+					},
+				},
+			},
+		},
+		"missing assume_role": {
+			tfList: []any{
+				map[string]any{
+					"service":     "route53",
+					"assume_role": []any{},
+				},
+			},
+			expectedRoles: map[string][]awsbase.AssumeRole{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeRequiredError(path.IndexInt(0), "assume_role"),
+			},
+		},
+		"missing role_arn": {
+			tfList: []any{
+				map[string]any{
+					"service": "route53",
+					"assume_role": []any{
+						map[string]any{
+							"role_arn": "",
+						},
+					},
+				},
+			},
+			expectedRoles: map[string][]awsbase.AssumeRole{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeRequiredError(path.IndexInt(0).GetAttr("assume_role").IndexInt(0), "role_arn"),
+			},
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{
+					"service": "logs",
+					"assume_role": []any{
+						map[string]any{
+							"role_arn": "arn:aws:iam::111111111111:role/first", //lintignore:AWSAT005
+						},
+					},
+				},
+				map[string]any{
+					"service": "cloudwatchlogs",
+					"assume_role": []any{
+						map[string]any{
+							"role_arn": "arn:aws:iam::222222222222:role/second", //lintignore:AWSAT005
+						},
+					},
+				},
+			},
+			expectedRoles: map[string][]awsbase.AssumeRole{
+				names.Logs: {
+					{
+						RoleARN: "arn:aws:iam::111111111111:role/first", //lintignore:AWSAT005
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(path.IndexInt(1).GetAttr("service"), `Duplicate IAM Role for service "logs"`),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			roles, diags := expandServiceAssumeRoles(ctx, path, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(roles, testcase.expectedRoles); diff != "" {
+				t.Errorf("unexpected roles difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandDefaultTagsRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("default_tags").IndexInt(0).GetAttr("rule")
+	testcases := map[string]struct {
+		tfList        []any
+		expectedRules []tftags.DefaultTagsRule
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfList:        []any{},
+			expectedRules: []tftags.DefaultTagsRule{},
+		},
+		"matchers": {
+			tfList: []any{
+				map[string]any{
+					"tags": map[string]any{
+						"Backup": "daily",
+					},
+					"include_resource_types": schema.NewSet(schema.HashString, []any{"aws_db_instance"}),
+					"include_services":       schema.NewSet(schema.HashString, []any{"cloudwatchlogs"}),
+					"exclude_resource_types": schema.NewSet(schema.HashString, []any{"aws_db_proxy"}),
+					"exclude_services":       schema.NewSet(schema.HashString, []any{"autoscaling"}),
+				},
+			},
+			expectedRules: []tftags.DefaultTagsRule{
+				{
+					Tags: tftags.New(ctx, map[string]string{
+						"Backup": "daily",
+					}),
+					IncludeResourceTypes: []string{"aws_db_instance"},
+					IncludeServices:      []string{names.Logs},
+					ExcludeResourceTypes: []string{"aws_db_proxy"},
+					ExcludeServices:      []string{names.AutoScaling},
+				},
+			},
+		},
+		"unsupported service": {
+			tfList: []any{
+				map[string]any{
+					"tags": map[string]any{
+						"Backup": "daily",
+					},
+					"include_services": schema.NewSet(schema.HashString, []any{"nosuchservice"}),
+				},
+			},
+			expectedRules: []tftags.DefaultTagsRule{
+				{
+					Tags: tftags.New(ctx, map[string]string{
+						"Backup": "daily",
+					}),
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(path.IndexInt(0).GetAttr("include_services"), `Unsupported service "nosuchservice"`),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, diags := expandDefaultTagsRules(ctx, path, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(rules, testcase.expectedRules); diff != "" {
+				t.Errorf("unexpected rules difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandServicePackageNames(t *testing.T) {
+	t.Parallel()
+
+	path := cty.GetAttrPath("default_tags").IndexInt(0).GetAttr("exclude_services")
+	testcases := map[string]struct {
+		set                         *schema.Set
+		expectedServicePackageNames []string
+		expectedDiags               diag.Diagnostics
+	}{
+		"empty": {
+			set: schema.NewSet(schema.HashString, []any{}),
+		},
+		"aliases": {
+			set:                         schema.NewSet(schema.HashString, []any{"cloudwatchlogs"}),
+			expectedServicePackageNames: []string{names.Logs},
+		},
+		"unsupported service": {
+			set:                         schema.NewSet(schema.HashString, []any{"autoscaling", "nosuchservice"}),
+			expectedServicePackageNames: []string{names.AutoScaling},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(path, `Unsupported service "nosuchservice"`),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			servicePackageNames, diags := expandServicePackageNames(path, testcase.set)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(servicePackageNames, testcase.expectedServicePackageNames); diff != "" {
+				t.Errorf("unexpected service package names difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandServiceRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	testcases := map[string]struct {
+		tfList         []any
+		expectedLimits map[string]conns.ServiceRateLimit
+		expectedDiags  diag.Diagnostics
+	}{
+		"empty": {
+			tfList:         []any{},
+			expectedLimits: map[string]conns.ServiceRateLimit{},
+		},
+		"service package names": {
+			tfList: []any{
+				map[string]any{
+					"service":             names.Route53,
+					"requests_per_second": 5.0,
+					"burst":               10,
+					"max_in_flight":       0,
+				},
+				map[string]any{
+					"service":             names.Organizations,
+					"requests_per_second": 0.0,
+					"burst":               0,
+					"max_in_flight":       2,
+				},
+			},
+			expectedLimits: map[string]conns.ServiceRateLimit{
+				names.Route53: {
+					RequestsPerSecond: 5,
+					Burst:             10,
+				},
+				names.Organizations: {
+					MaxInFlight: 2,
+				},
+			},
+		},
+		"alias": {
+			tfList: []any{
+				map[string]any{
+					"service":             "cloudwatchlogs",
+					"requests_per_second": 1.0,
+					"burst":               0,
+					"max_in_flight":       0,
+				},
+			},
+			expectedLimits: map[string]conns.ServiceRateLimit{
+				names.Logs: {
+					RequestsPerSecond: 1,
+				},
+			},
+		},
+		"unsupported service": {
+			tfList: []any{
+				map[string]any{
+					"service":             "nosuchservice",
+					"requests_per_second": 1.0,
+					"burst":               0,
+					"max_in_flight":       0,
+				},
+			},
+			expectedLimits: map[string]conns.ServiceRateLimit{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(
+					cty.GetAttrPath("service_rate_limits").IndexInt(0).GetAttr("service"),
+					`Unsupported service "nosuchservice"`,
+				),
+			},
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{
+					"service":             names.Logs,
+					"requests_per_second": 1.0,
+					"burst":               0,
+					"max_in_flight":       0,
+				},
+				map[string]any{
+					"service":             "cloudwatchlogs",
+					"requests_per_second": 2.0,
+					"burst":               0,
+					"max_in_flight":       0,
+				},
+			},
+			expectedLimits: map[string]conns.ServiceRateLimit{
+				names.Logs: {
+					RequestsPerSecond: 1,
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(
+					cty.GetAttrPath("service_rate_limits").IndexInt(1).GetAttr("service"),
+					`Duplicate rate limit for service "logs"`,
+				),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			limits, diags := expandServiceRateLimits(ctx, cty.GetAttrPath("service_rate_limits"), testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(limits, testcase.expectedLimits); diff != "" {
+				t.Errorf("unexpected limits difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandIgnoreTagsRules(t *testing.T) {
+	t.Parallel()
+
+	path := cty.GetAttrPath("ignore_tags").IndexInt(0).GetAttr("rule")
+	testcases := map[string]struct {
+		tfList        []any
+		expectedRules []tftags.IgnoreTagsRule
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfList:        []any{},
+			expectedRules: []tftags.IgnoreTagsRule{},
+		},
+		"rule": {
+			tfList: []any{
+				map[string]any{
+					"keys":           schema.NewSet(schema.HashString, []any{"AWSBackup"}),
+					"key_prefixes":   schema.NewSet(schema.HashString, []any{"backup:"}),
+					"resource_types": schema.NewSet(schema.HashString, []any{"aws_db_instance"}),
+					"value_regex":    `^\d+$`,
+				},
+			},
+			expectedRules: []tftags.IgnoreTagsRule{
+				{
+					Keys:          []string{"AWSBackup"},
+					KeyPrefixes:   []string{"backup:"},
+					ValueRegex:    regexp.MustCompile(`^\d+$`),
+					ResourceTypes: []string{"aws_db_instance"},
+				},
+			},
+		},
+		"invalid regex": {
+			tfList: []any{
+				map[string]any{
+					"value_regex": `(`,
+				},
+			},
+			expectedRules: []tftags.IgnoreTagsRule{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(path.IndexInt(0).GetAttr("value_regex"), "Invalid regular expression: error parsing regexp: missing closing ): `(`"),
+			},
+		},
+		"no matchers": {
+			tfList: []any{
+				map[string]any{
+					"resource_types": schema.NewSet(schema.HashString, []any{"aws_db_instance"}),
+					"value_regex":    "",
+				},
+			},
+			expectedRules: []tftags.IgnoreTagsRule{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAtLeastOneOfChildrenError(path.IndexInt(0), path.IndexInt(0).GetAttr("keys"), path.IndexInt(0).GetAttr("key_prefixes"), path.IndexInt(0).GetAttr("value_regex")),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, diags := expandIgnoreTagsRules(path, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(rules, testcase.expectedRules, cmp.Comparer(func(x, y *regexp.Regexp) bool {
+				return x.String() == y.String()
+			})); diff != "" {
+				t.Errorf("unexpected rules difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := t.Context()
 	testcases := map[string]struct {
@@ -5,6 +5,7 @@ package sdkv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"unique"
@@ -300,6 +301,8 @@ func setTagsAll() customizeDiffInterceptor {
 	})
 }
 
+// validateRequiredTags validates that a given resource type's tags comply with organizational tag policies:
+// required tags are present, tag keys are correctly capitalized and tag values are allowed.
 func validateRequiredTags() customizeDiffInterceptor {
 	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
 		c := opts.c
@@ -313,8 +316,8 @@ func validateRequiredTags() customizeDiffInterceptor {
 		if policy == nil {
 			return nil
 		}
-		reqTags, ok := policy.RequiredTags[typeName]
-		if !ok {
+		reqTags, hasReqTags := policy.RequiredTags[typeName]
+		if !hasReqTags && !policy.HasTagKeyPolicies() {
 			return nil
 		}
 
@@ -335,27 +338,45 @@ func validateRequiredTags() customizeDiffInterceptor {
 
 				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
 				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
-				if allTags.ContainsAllKeys(reqTags) {
-					return nil
+
+				type finding struct {
+					summary, detail string
+				}
+				var findings []finding
+
+				if hasReqTags && !allTags.ContainsAllKeys(reqTags) {
+					missing := reqTags.Removed(allTags).Keys()
+					slices.Sort(missing)
+					findings = append(findings, finding{
+						summary: "Missing Required Tags",
+						detail:  fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing),
+					})
 				}
 
-				missing := reqTags.Removed(allTags).Keys()
-				slices.Sort(missing)
-				summary := "Missing Required Tags"
-				detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+				for _, violation := range policy.Violations(typeName, allTags) {
+					findings = append(findings, finding{
+						summary: tftags.TagPolicyViolationSummary,
+						detail:  violation.Detail(typeName),
+					})
+				}
 
 				// CustomizeDiff does not support diagnostics (only an error return)
-				switch policy.Severity {
-				case "warning":
-					// Warning diagnostics are only logged
-					tflog.Warn(ctx, "Required Tags Validation", map[string]any{
-						"summary": summary,
-						"detail":  detail,
-					})
-				default:
-					// Error diagnostics merge summary and detail into a single message
-					return fmt.Errorf("%s - %s", summary, detail)
+				var errs []error
+				for _, f := range findings {
+					switch policy.Severity {
+					case "warning":
+						// Warning diagnostics are only logged
+						tflog.Warn(ctx, "Tag Policy Validation", map[string]any{
+							"summary": f.summary,
+							"detail":  f.detail,
+						})
+					default:
+						// Error diagnostics merge summary and detail into a single message
+						errs = append(errs, fmt.Errorf("%s - %s", f.summary, f.detail))
+					}
 				}
+
+				return errors.Join(errs...)
 			}
 		}
 
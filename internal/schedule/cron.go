@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	cronMinYear = 1970
+	cronMaxYear = 2199
+)
+
+var (
+	cronMonthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	cronDayOfWeekNames = map[string]int{
+		"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+	}
+)
+
+// cronSet is the set of values matched by a cron field, indexed by value.
+type cronSet []bool
+
+// cronExpression fires at the times matching all of its fields.
+type cronExpression struct {
+	loc         *time.Location
+	minutes     cronSet
+	hours       cronSet
+	daysOfMonth cronSet
+	months      cronSet
+	daysOfWeek  cronSet
+	years       cronSet
+
+	anyDayOfMonth bool // Day-of-month is "?".
+	anyDayOfWeek  bool // Day-of-week is "?".
+
+	lastDayOfMonth        bool // Day-of-month is "L".
+	lastWeekdayOfMonth    bool // Day-of-month is "LW".
+	nearestWeekdayOfMonth int  // Day-of-month is "<n>W".
+
+	lastDayOfWeekInMonth int // Day-of-week is "<n>L".
+	nthDayOfWeek         int // Day-of-week is "<n>#<k>", this is <n>.
+	nthDayOfWeekInMonth  int // Day-of-week is "<n>#<k>", this is <k>.
+}
+
+func parseCron(s string, loc *time.Location) (Expression, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("cron(%s): must have 6 fields (minutes, hours, day-of-month, month, day-of-week and year), got %d", s, len(fields))
+	}
+
+	e := &cronExpression{loc: loc}
+	var err error
+
+	if e.minutes, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("cron(%s): minutes: %w", s, err)
+	}
+	if e.hours, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("cron(%s): hours: %w", s, err)
+	}
+	if err = e.parseDayOfMonth(fields[2]); err != nil {
+		return nil, fmt.Errorf("cron(%s): day-of-month: %w", s, err)
+	}
+	if e.months, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("cron(%s): month: %w", s, err)
+	}
+	if err = e.parseDayOfWeek(fields[4]); err != nil {
+		return nil, fmt.Errorf("cron(%s): day-of-week: %w", s, err)
+	}
+	if e.years, err = parseCronField(fields[5], cronMinYear, cronMaxYear, nil); err != nil {
+		return nil, fmt.Errorf("cron(%s): year: %w", s, err)
+	}
+
+	if e.anyDayOfMonth == e.anyDayOfWeek {
+		return nil, fmt.Errorf("cron(%s): exactly one of day-of-month or day-of-week must be ?", s)
+	}
+
+	return e, nil
+}
+
+func (e *cronExpression) parseDayOfMonth(s string) error {
+	var err error
+
+	switch {
+	case s == "?":
+		e.anyDayOfMonth = true
+	case s == "L":
+		e.lastDayOfMonth = true
+	case s == "LW":
+		e.lastWeekdayOfMonth = true
+	case strings.HasSuffix(s, "W"):
+		if e.nearestWeekdayOfMonth, err = parseCronValue(strings.TrimSuffix(s, "W"), 1, 31, nil); err != nil {
+			return err
+		}
+	default:
+		if e.daysOfMonth, err = parseCronField(s, 1, 31, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *cronExpression) parseDayOfWeek(s string) error {
+	var err error
+
+	switch {
+	case s == "?":
+		e.anyDayOfWeek = true
+	case s == "L":
+		e.daysOfWeek = make(cronSet, 8)
+		e.daysOfWeek[7] = true
+	case strings.HasSuffix(s, "L"):
+		if e.lastDayOfWeekInMonth, err = parseCronValue(strings.TrimSuffix(s, "L"), 1, 7, cronDayOfWeekNames); err != nil {
+			return err
+		}
+	case strings.Contains(s, "#"):
+		day, nth, _ := strings.Cut(s, "#")
+		if e.nthDayOfWeek, err = parseCronValue(day, 1, 7, cronDayOfWeekNames); err != nil {
+			return err
+		}
+		if e.nthDayOfWeekInMonth, err = parseCronValue(nth, 1, 5, nil); err != nil {
+			return err
+		}
+	default:
+		if e.daysOfWeek, err = parseCronField(s, 1, 7, cronDayOfWeekNames); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges, wildcards and increments.
+func parseCronField(s string, minValue, maxValue int, names map[string]int) (cronSet, error) {
+	set := make(cronSet, maxValue+1)
+
+	for item := range strings.SplitSeq(s, ",") {
+		expr, step, hasStep := strings.Cut(item, "/")
+
+		var from, to int
+		switch lo, hi, isRange := strings.Cut(expr, "-"); {
+		case expr == "*":
+			from, to = minValue, maxValue
+		case isRange:
+			var err error
+			if from, err = parseCronValue(lo, minValue, maxValue, names); err != nil {
+				return nil, err
+			}
+			if to, err = parseCronValue(hi, minValue, maxValue, names); err != nil {
+				return nil, err
+			}
+			if from > to {
+				return nil, fmt.Errorf("invalid range %q", expr)
+			}
+		default:
+			var err error
+			if from, err = parseCronValue(expr, minValue, maxValue, names); err != nil {
+				return nil, err
+			}
+			to = from
+			if hasStep {
+				to = maxValue
+			}
+		}
+
+		increment := 1
+		if hasStep {
+			var err error
+			if increment, err = strconv.Atoi(step); err != nil || increment < 1 {
+				return nil, fmt.Errorf("invalid increment %q", step)
+			}
+		}
+
+		for v := from; v <= to; v += increment {
+			set[v] = true
+		}
+	}
+
+	return set, nil
+}
+
+func parseCronValue(s string, minValue, maxValue int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+
+	if v < minValue || v > maxValue {
+		return 0, fmt.Errorf("value %d must be between %d and %d", v, minValue, maxValue)
+	}
+
+	return v, nil
+}
+
+func (e *cronExpression) Next(t time.Time) time.Time {
+	t = t.In(e.loc)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, e.loc)
+
+	for day.Year() <= cronMaxYear {
+		year, month := day.Year(), int(day.Month())
+
+		switch {
+		case year < cronMinYear || !e.years[year]:
+			day = time.Date(year+1, time.January, 1, 0, 0, 0, 0, e.loc)
+			continue
+		case !e.months[month]:
+			day = time.Date(year, time.Month(month+1), 1, 0, 0, 0, 0, e.loc)
+			continue
+		}
+
+		if e.matchesDay(day) {
+			for hour, ok := range e.hours {
+				if !ok {
+					continue
+				}
+				for minute, ok := range e.minutes {
+					if !ok {
+						continue
+					}
+
+					next := time.Date(year, time.Month(month), day.Day(), hour, minute, 0, 0, e.loc)
+
+					// Skip wall clock times that do not exist because of daylight saving time transitions.
+					if next.Hour() != hour || next.Minute() != minute {
+						continue
+					}
+
+					if next.After(t) {
+						return next
+					}
+				}
+			}
+		}
+
+		day = time.Date(year, time.Month(month), day.Day()+1, 0, 0, 0, 0, e.loc)
+	}
+
+	return time.Time{}
+}
+
+func (e *cronExpression) matchesDay(day time.Time) bool {
+	d := day.Day()
+	lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, e.loc).Day()
+
+	if !e.anyDayOfMonth {
+		switch {
+		case e.lastDayOfMonth:
+			return d == lastDay
+		case e.lastWeekdayOfMonth:
+			return d == nearestWeekday(day, lastDay)
+		case e.nearestWeekdayOfMonth > 0:
+			// Months without the specified day are skipped.
+			if e.nearestWeekdayOfMonth > lastDay {
+				return false
+			}
+			return d == nearestWeekday(day, e.nearestWeekdayOfMonth)
+		default:
+			return e.daysOfMonth[d]
+		}
+	}
+
+	dayOfWeek := int(day.Weekday()) + 1 // Sunday is 1.
+
+	switch {
+	case e.lastDayOfWeekInMonth > 0:
+		return dayOfWeek == e.lastDayOfWeekInMonth && d+7 > lastDay
+	case e.nthDayOfWeek > 0:
+		return dayOfWeek == e.nthDayOfWeek && (d-1)/7+1 == e.nthDayOfWeekInMonth
+	default:
+		return e.daysOfWeek[dayOfWeek]
+	}
+}
+
+// nearestWeekday returns the day of the month of the weekday nearest to the
+// specified day in the same month as the reference day.
+func nearestWeekday(ref time.Time, d int) int {
+	t := time.Date(ref.Year(), ref.Month(), d, 0, 0, 0, 0, ref.Location())
+	lastDay := time.Date(ref.Year(), ref.Month()+1, 0, 0, 0, 0, 0, ref.Location()).Day()
+
+	switch t.Weekday() {
+	case time.Saturday:
+		if d == 1 {
+			return d + 2
+		}
+		return d - 1
+	case time.Sunday:
+		if d == lastDay {
+			return d - 2
+		}
+		return d + 1
+	default:
+		return d
+	}
+}
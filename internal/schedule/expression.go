@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schedule parses the schedule expressions accepted by Amazon
+// EventBridge rules and EventBridge Scheduler schedules.
+//
+// Ref:
+// - https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html.
+// - https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html.
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	atLayout = "2006-01-02T15:04:05"
+)
+
+// Expression is a parsed schedule expression.
+type Expression interface {
+	// Next returns the first time the schedule fires strictly after t, or the
+	// zero time if the schedule never fires again.
+	Next(t time.Time) time.Time
+}
+
+// Parse parses a `cron()`, `rate()` or `at()` schedule expression.
+// Times are evaluated in the specified location, which defaults to UTC.
+func Parse(s string, loc *time.Location) (Expression, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	switch {
+	case strings.HasPrefix(s, "cron(") && strings.HasSuffix(s, ")"):
+		return parseCron(strings.TrimSuffix(strings.TrimPrefix(s, "cron("), ")"), loc)
+	case strings.HasPrefix(s, "rate(") && strings.HasSuffix(s, ")"):
+		return parseRate(strings.TrimSuffix(strings.TrimPrefix(s, "rate("), ")"))
+	case strings.HasPrefix(s, "at(") && strings.HasSuffix(s, ")"):
+		return parseAt(strings.TrimSuffix(strings.TrimPrefix(s, "at("), ")"), loc)
+	default:
+		return nil, fmt.Errorf("%q is not a valid schedule expression; must be one of cron(...), rate(...) or at(...)", s)
+	}
+}
+
+// rateExpression fires at a fixed interval.
+type rateExpression struct {
+	interval time.Duration
+}
+
+// Next returns t plus the interval. Rate schedules fire relative to the time
+// the schedule is created, so t is treated as the previous fire time.
+func (e rateExpression) Next(t time.Time) time.Time {
+	return t.Add(e.interval)
+}
+
+func parseRate(s string) (Expression, error) {
+	value, unit, ok := strings.Cut(s, " ")
+	if !ok {
+		return nil, fmt.Errorf("rate(%s): must be of the form rate(value unit)", s)
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return nil, fmt.Errorf("rate(%s): value must be a positive integer", s)
+	}
+
+	var interval time.Duration
+	switch strings.TrimSuffix(unit, "s") {
+	case "minute":
+		interval = time.Minute
+	case "hour":
+		interval = time.Hour
+	case "day":
+		interval = 24 * time.Hour
+	default:
+		return nil, fmt.Errorf("rate(%s): unit must be one of minute(s), hour(s) or day(s)", s)
+	}
+
+	if plural := strings.HasSuffix(unit, "s"); n == 1 && plural {
+		return nil, fmt.Errorf("rate(%s): unit must be singular for a value of 1", s)
+	} else if n > 1 && !plural {
+		return nil, fmt.Errorf("rate(%s): unit must be plural for a value greater than 1", s)
+	}
+
+	return rateExpression{interval: time.Duration(n) * interval}, nil
+}
+
+// atExpression fires once.
+type atExpression struct {
+	at time.Time
+}
+
+func (e atExpression) Next(t time.Time) time.Time {
+	if e.at.After(t) {
+		return e.at
+	}
+
+	return time.Time{}
+}
+
+func parseAt(s string, loc *time.Location) (Expression, error) {
+	at, err := time.ParseInLocation(atLayout, s, loc)
+	if err != nil {
+		return nil, fmt.Errorf("at(%s): must be of the form at(yyyy-mm-ddThh:mm:ss): %w", s, err)
+	}
+
+	return atExpression{at: at}, nil
+}
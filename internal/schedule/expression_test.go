@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError bool
+	}{
+		{name: "empty", input: "", expectedError: true},
+		{name: "unknown", input: "every(5 minutes)", expectedError: true},
+		{name: "rate minute", input: "rate(1 minute)"},
+		{name: "rate minutes", input: "rate(5 minutes)"},
+		{name: "rate hours", input: "rate(12 hours)"},
+		{name: "rate day", input: "rate(1 day)"},
+		{name: "rate plural for 1", input: "rate(1 minutes)", expectedError: true},
+		{name: "rate singular for 5", input: "rate(5 minute)", expectedError: true},
+		{name: "rate zero", input: "rate(0 minutes)", expectedError: true},
+		{name: "rate unit", input: "rate(5 weeks)", expectedError: true},
+		{name: "rate format", input: "rate(5)", expectedError: true},
+		{name: "at", input: "at(2030-11-20T13:00:00)"},
+		{name: "at format", input: "at(2030-11-20 13:00)", expectedError: true},
+		{name: "cron every 5 minutes", input: "cron(0/5 * * * ? *)"},
+		{name: "cron weekdays", input: "cron(15 10 ? * MON-FRI *)"},
+		{name: "cron last day of month", input: "cron(0 18 L * ? *)"},
+		{name: "cron last weekday of month", input: "cron(0 18 LW * ? *)"},
+		{name: "cron nearest weekday", input: "cron(0 8 15W * ? *)"},
+		{name: "cron last Friday", input: "cron(0 8 ? * 6L *)"},
+		{name: "cron second Tuesday", input: "cron(0 8 ? * 3#2 *)"},
+		{name: "cron months", input: "cron(0 8 1 JAN,JUL ? 2025-2030)"},
+		{name: "cron fields", input: "cron(0 8 * * ?)", expectedError: true},
+		{name: "cron both days", input: "cron(0 8 1 * MON *)", expectedError: true},
+		{name: "cron neither day", input: "cron(0 8 ? * ? *)", expectedError: true},
+		{name: "cron minutes range", input: "cron(60 8 * * ? *)", expectedError: true},
+		{name: "cron hours range", input: "cron(0 24 * * ? *)", expectedError: true},
+		{name: "cron reversed range", input: "cron(0 8 ? * FRI-MON *)", expectedError: true},
+		{name: "cron increment", input: "cron(0/0 8 * * ? *)", expectedError: true},
+		{name: "cron year range", input: "cron(0 8 * * ? 2200)", expectedError: true},
+		{name: "cron nth", input: "cron(0 8 ? * 3#6 *)", expectedError: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(testCase.input, nil)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("Parse(%q) error: %v, expected error: %t", testCase.input, err, want)
+			}
+		})
+	}
+}
+
+func TestExpressionNext(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("loading time zone: %s", err)
+	}
+
+	testCases := []struct {
+		name     string
+		input    string
+		location *time.Location
+		start    string
+		expected []string
+	}{
+		{
+			name:     "rate",
+			input:    "rate(2 hours)",
+			start:    "2025-01-01T00:00:00Z",
+			expected: []string{"2025-01-01T02:00:00Z", "2025-01-01T04:00:00Z"},
+		},
+		{
+			name:     "at",
+			input:    "at(2025-01-01T12:00:00)",
+			start:    "2025-01-01T00:00:00Z",
+			expected: []string{"2025-01-01T12:00:00Z", ""},
+		},
+		{
+			name:     "at in location",
+			input:    "at(2025-01-01T12:00:00)",
+			location: newYork,
+			start:    "2025-01-01T00:00:00Z",
+			expected: []string{"2025-01-01T12:00:00-05:00"},
+		},
+		{
+			name:     "cron every 15 minutes",
+			input:    "cron(0/15 * * * ? *)",
+			start:    "2025-01-01T00:07:30Z",
+			expected: []string{"2025-01-01T00:15:00Z", "2025-01-01T00:30:00Z", "2025-01-01T00:45:00Z", "2025-01-01T01:00:00Z"},
+		},
+		{
+			name:     "cron weekdays",
+			input:    "cron(15 10 ? * MON-FRI *)",
+			start:    "2025-01-03T11:00:00Z", // Friday.
+			expected: []string{"2025-01-06T10:15:00Z", "2025-01-07T10:15:00Z"},
+		},
+		{
+			name:     "cron last day of month",
+			input:    "cron(0 18 L * ? *)",
+			start:    "2024-02-01T00:00:00Z",
+			expected: []string{"2024-02-29T18:00:00Z", "2024-03-31T18:00:00Z"},
+		},
+		{
+			name:     "cron last weekday of month",
+			input:    "cron(0 18 LW * ? *)",
+			start:    "2025-05-01T00:00:00Z",
+			expected: []string{"2025-05-30T18:00:00Z", "2025-06-30T18:00:00Z"},
+		},
+		{
+			name:     "cron nearest weekday",
+			input:    "cron(0 8 15W * ? *)",
+			start:    "2025-03-01T00:00:00Z",
+			expected: []string{"2025-03-14T08:00:00Z", "2025-04-15T08:00:00Z", "2025-05-15T08:00:00Z", "2025-06-16T08:00:00Z"},
+		},
+		{
+			name:     "cron nearest weekday skips short months",
+			input:    "cron(0 8 31W * ? *)",
+			start:    "2025-01-01T00:00:00Z",
+			expected: []string{"2025-01-31T08:00:00Z", "2025-03-31T08:00:00Z", "2025-05-30T08:00:00Z", "2025-07-31T08:00:00Z"},
+		},
+		{
+			name:     "cron last Friday",
+			input:    "cron(0 8 ? * 6L *)",
+			start:    "2025-01-01T00:00:00Z",
+			expected: []string{"2025-01-31T08:00:00Z", "2025-02-28T08:00:00Z"},
+		},
+		{
+			name:     "cron second Tuesday",
+			input:    "cron(0 8 ? * TUE#2 *)",
+			start:    "2025-01-01T00:00:00Z",
+			expected: []string{"2025-01-14T08:00:00Z", "2025-02-11T08:00:00Z"},
+		},
+		{
+			name:     "cron years",
+			input:    "cron(0 0 1 JAN ? 2026,2028)",
+			start:    "2025-06-01T00:00:00Z",
+			expected: []string{"2026-01-01T00:00:00Z", "2028-01-01T00:00:00Z", ""},
+		},
+		{
+			name:     "cron in location",
+			input:    "cron(0 9 * * ? *)",
+			location: newYork,
+			start:    "2025-03-08T00:00:00Z",
+			expected: []string{"2025-03-08T09:00:00-05:00", "2025-03-09T09:00:00-04:00"},
+		},
+		{
+			name:     "cron skipped by daylight saving time",
+			input:    "cron(30 2 * * ? *)",
+			location: newYork,
+			start:    "2025-03-08T12:00:00Z",
+			expected: []string{"2025-03-10T02:30:00-04:00"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := Parse(testCase.input, testCase.location)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", testCase.input, err)
+			}
+
+			next, err := time.Parse(time.RFC3339, testCase.start)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i, want := range testCase.expected {
+				next = e.Next(next)
+
+				var got string
+				if !next.IsZero() {
+					got = next.Format(time.RFC3339)
+				}
+
+				if got != want {
+					t.Errorf("Next #%d: got %q, expected %q", i, got, want)
+				}
+
+				if next.IsZero() {
+					break
+				}
+			}
+		})
+	}
+}
@@ -44,6 +44,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -4038,8 +4040,10 @@ type instanceListResource struct {
 
 type instanceListResourceModel struct {
 	framework.WithRegionModel
-	Filters           customListFilters `tfsdk:"filter"`
-	IncludeAutoScaled types.Bool        `tfsdk:"include_auto_scaled"`
+	listresource.WithNamePrefixModel
+	listresource.WithNameValuesFiltersModel
+	listresource.WithTagFiltersModel
+	IncludeAutoScaled types.Bool `tfsdk:"include_auto_scaled"`
 }
 
 func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
@@ -4049,9 +4053,11 @@ func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, req
 				Description: "Whether to include instances that are part of an Auto Scaling group. Auto scaled instances are excluded by default.",
 				Optional:    true,
 			},
+			names.AttrNamePrefix: listresourceattribute.NamePrefix(),
 		},
 		Blocks: map[string]listschema.Block{
-			names.AttrFilter: customListFiltersBlock(ctx),
+			names.AttrFilter: listresource.NameValuesFiltersBlock(ctx),
+			"tag":            listresource.TagFiltersBlock(ctx),
 		},
 	}
 }
@@ -4074,6 +4080,14 @@ func (l *instanceListResource) List(ctx context.Context, request list.ListReques
 		return
 	}
 
+	filter, diags := listresource.NewFilter(ctx, query)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	input.Filters = append(input.Filters, listFilterNameValuesFilters(filter).EC2Filters()...)
+
 	// If no instance-state filter is set, default to all states except terminated and shutting-down
 	if !slices.ContainsFunc(input.Filters, func(i awstypes.Filter) bool {
 		return aws.ToString(i.Name) == "instance-state-name" || aws.ToString(i.Name) == "instance-state-code"
@@ -4100,6 +4114,9 @@ func (l *instanceListResource) List(ctx context.Context, request list.ListReques
 			}
 
 			tags := keyValueTags(ctx, instance.Tags)
+			if !filter.Match(tags.KeyTagData("Name").ValueString(), tags) {
+				continue
+			}
 
 			if !includeAutoScaled {
 				// Exclude Auto Scaled Instances
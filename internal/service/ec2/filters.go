@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,6 +17,8 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -170,30 +171,34 @@ type (
 	customFilters = fwtypes.SetNestedObjectValueOf[customFilterModel]
 )
 
-func customListFiltersBlock(ctx context.Context) listschema.ListNestedBlock {
-	return listschema.ListNestedBlock{
-		CustomType: fwtypes.NewListNestedObjectTypeOf[customListFilterModel](ctx),
-		NestedObject: listschema.NestedBlockObject{
-			Attributes: map[string]listschema.Attribute{
-				names.AttrName: listschema.StringAttribute{
-					Required: true,
-				},
-				names.AttrValues: listschema.ListAttribute{
-					CustomType:  fwtypes.ListOfStringType,
-					ElementType: types.StringType,
-					Required:    true,
-				},
-			},
-		},
+// listFilterNameValuesFilters returns the EC2 filters that push a list resource's tag and name prefix filtering
+// down to the "Describe..." API call.
+// A tag key without values matches any value, and the name prefix matches the value of the "Name" tag.
+// EC2 filter values can contain wildcards, so results must still be matched client-side.
+func listFilterNameValuesFilters(filter listresource.Filter) namevaluesfilters.NameValuesFilters {
+	filters := make(namevaluesfilters.NameValuesFilters)
+
+	// Values of filters with the same name are ORed together, so only the first condition on each tag key is pushed down.
+	for _, condition := range filter.Tags {
+		name := "tag:" + condition.Key
+		if _, ok := filters[name]; ok {
+			continue
+		}
+
+		values := condition.Values
+		if len(values) == 0 {
+			values = []string{"*"}
+		}
+		filters.Add(map[string][]string{name: values})
 	}
-}
 
-type customListFilterModel struct {
-	Name   types.String         `tfsdk:"name"`
-	Values fwtypes.ListOfString `tfsdk:"values"`
-}
+	// A "Name" tag filter takes precedence.
+	if _, ok := filters["tag:Name"]; !ok && filter.NamePrefix != "" {
+		filters.Add(map[string]string{"tag:Name": filter.NamePrefix + "*"})
+	}
 
-type customListFilters = fwtypes.ListNestedObjectValueOf[customListFilterModel]
+	return filters
+}
 
 // newCustomFilterList takes the set value extracted from a schema
 // attribute conforming to the schema returned by CustomFiltersSchema,
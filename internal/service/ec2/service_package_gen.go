@@ -1504,7 +1504,15 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			}),
-			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"ec2:CreateSecurityGroup",
+				},
+				Delete: []string{
+					"ec2:DeleteSecurityGroup",
+				},
+			},
 			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrID),
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
@@ -1808,7 +1816,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			}),
-			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Region:  unique.Make(inttypes.ResourceRegionDefault()),
+			Account: unique.Make(inttypes.ResourceAccountAssumeRoleOverrideEnabled()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"ec2:AcceptVpcPeeringConnection",
+					"ec2:ModifyVpcPeeringConnectionOptions",
+					"ec2:CreateTags",
+				},
+				Update: []string{
+					"ec2:AcceptVpcPeeringConnection",
+					"ec2:ModifyVpcPeeringConnectionOptions",
+					"ec2:CreateTags",
+					"ec2:DeleteTags",
+				},
+			},
 		},
 		{
 			Factory:  resourceVPCPeeringConnectionOptions,
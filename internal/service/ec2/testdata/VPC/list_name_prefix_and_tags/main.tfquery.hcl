@@ -0,0 +1,15 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_vpc" "test" {
+  provider = aws
+
+  config {
+    name_prefix = var.rName
+
+    tag {
+      key    = "Environment"
+      values = ["production"]
+    }
+  }
+}
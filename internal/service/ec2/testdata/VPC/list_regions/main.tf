@@ -0,0 +1,28 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_vpc" "test" {
+  region = var.region
+
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_vpc" "alternate" {
+  region = var.alternate_region
+
+  cidr_block = "10.1.0.0/16"
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
+
+variable "alternate_region" {
+  description = "Region to deploy the alternate resource in"
+  type        = string
+  nullable    = false
+}
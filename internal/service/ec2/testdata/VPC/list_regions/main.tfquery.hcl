@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_vpc" "test" {
+  provider = aws
+
+  config {
+    regions = [var.region, var.alternate_region]
+  }
+}
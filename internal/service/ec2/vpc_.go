@@ -32,6 +32,8 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -769,13 +771,16 @@ type vpcListResource struct {
 
 type vpcListResourceModel struct {
 	framework.WithRegionModel
-	VPCIDs  fwtypes.ListValueOf[types.String] `tfsdk:"vpc_ids"`
-	Filters customListFilters                 `tfsdk:"filter"`
+	listresource.WithNamePrefixModel
+	listresource.WithNameValuesFiltersModel
+	listresource.WithTagFiltersModel
+	VPCIDs fwtypes.ListValueOf[types.String] `tfsdk:"vpc_ids"`
 }
 
 func (l *vpcListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
 	response.Schema = listschema.Schema{
 		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listresourceattribute.NamePrefix(),
 			"vpc_ids": listschema.ListAttribute{
 				CustomType:  fwtypes.ListOfStringType,
 				ElementType: types.StringType,
@@ -783,24 +788,8 @@ func (l *vpcListResource) ListResourceConfigSchema(ctx context.Context, request
 			},
 		},
 		Blocks: map[string]listschema.Block{
-			names.AttrFilter: listschema.ListNestedBlock{
-				CustomType: fwtypes.NewListNestedObjectTypeOf[customListFilterModel](ctx),
-				NestedObject: listschema.NestedBlockObject{
-					Attributes: map[string]listschema.Attribute{
-						names.AttrName: listschema.StringAttribute{
-							Required: true,
-							Validators: []validator.String{
-								notIsDefaultValidator{},
-							},
-						},
-						names.AttrValues: listschema.ListAttribute{
-							CustomType:  fwtypes.ListOfStringType,
-							ElementType: types.StringType,
-							Required:    true,
-						},
-					},
-				},
-			},
+			names.AttrFilter: listresource.NameValuesFiltersBlock(ctx, notIsDefaultValidator{}),
+			"tag":            listresource.TagFiltersBlock(ctx),
 		},
 	}
 }
@@ -858,6 +847,13 @@ func (l *vpcListResource) List(ctx context.Context, request list.ListRequest, st
 		return
 	}
 
+	filter, diags := listresource.NewFilter(ctx, query)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	input.Filters = append(input.Filters, listFilterNameValuesFilters(filter).EC2Filters()...)
 	input.Filters = append(input.Filters, awstypes.Filter{
 		Name:   aws.String("is-default"),
 		Values: []string{"false"},
@@ -878,9 +874,12 @@ func (l *vpcListResource) List(ctx context.Context, request list.ListRequest, st
 			for _, vpc := range page.Vpcs {
 				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), aws.ToString(vpc.VpcId))
 
-				result := request.NewListResult(ctx)
-
 				tags := keyValueTags(ctx, vpc.Tags)
+				if !filter.Match(tags.KeyTagData("Name").ValueString(), tags) {
+					continue
+				}
+
+				result := request.NewListResult(ctx)
 
 				rd := l.ResourceData()
 				rd.SetId(aws.ToString(vpc.VpcId))
@@ -17,6 +17,8 @@ import (
 )
 
 // @SDKResource("aws_vpc_peering_connection_accepter", name="VPC Peering Connection")
+// @AssumeRoleOverride
+// @Permissions(create="ec2:AcceptVpcPeeringConnection;ec2:ModifyVpcPeeringConnectionOptions;ec2:CreateTags", update="ec2:AcceptVpcPeeringConnection;ec2:ModifyVpcPeeringConnectionOptions;ec2:CreateTags;ec2:DeleteTags")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
 func resourceVPCPeeringConnectionAccepter() *schema.Resource {
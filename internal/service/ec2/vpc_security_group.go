@@ -35,6 +35,7 @@ import (
 
 // @SDKResource("aws_security_group", name="Security Group")
 // @Tags(identifierAttribute="id")
+// @Permissions(create="ec2:CreateSecurityGroup", delete="ec2:DeleteSecurityGroup")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.SecurityGroup")
 // @Testing(importIgnore="revoke_rules_on_delete")
 // @IdentityAttribute("id")
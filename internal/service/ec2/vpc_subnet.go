@@ -30,6 +30,8 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -716,13 +718,16 @@ type subnetListResource struct {
 
 type subnetListResourceModel struct {
 	framework.WithRegionModel
+	listresource.WithNamePrefixModel
+	listresource.WithNameValuesFiltersModel
+	listresource.WithTagFiltersModel
 	SubnetIDs fwtypes.ListValueOf[types.String] `tfsdk:"subnet_ids"`
-	Filters   customListFilters                 `tfsdk:"filter"`
 }
 
 func (l *subnetListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
 	response.Schema = listschema.Schema{
 		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listresourceattribute.NamePrefix(),
 			names.AttrSubnetIDs: listschema.ListAttribute{
 				CustomType:  fwtypes.ListOfStringType,
 				ElementType: types.StringType,
@@ -730,24 +735,8 @@ func (l *subnetListResource) ListResourceConfigSchema(ctx context.Context, reque
 			},
 		},
 		Blocks: map[string]listschema.Block{
-			names.AttrFilter: listschema.ListNestedBlock{
-				CustomType: fwtypes.NewListNestedObjectTypeOf[customListFilterModel](ctx),
-				NestedObject: listschema.NestedBlockObject{
-					Attributes: map[string]listschema.Attribute{
-						names.AttrName: listschema.StringAttribute{
-							Required: true,
-							Validators: []validator.String{
-								notDefaultForAZValidator{},
-							},
-						},
-						names.AttrValues: listschema.ListAttribute{
-							CustomType:  fwtypes.ListOfStringType,
-							ElementType: types.StringType,
-							Required:    true,
-						},
-					},
-				},
-			},
+			names.AttrFilter: listresource.NameValuesFiltersBlock(ctx, notDefaultForAZValidator{}),
+			"tag":            listresource.TagFiltersBlock(ctx),
 		},
 	}
 }
@@ -805,6 +794,13 @@ func (l *subnetListResource) List(ctx context.Context, request list.ListRequest,
 		return
 	}
 
+	filter, diags := listresource.NewFilter(ctx, query)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	input.Filters = append(input.Filters, listFilterNameValuesFilters(filter).EC2Filters()...)
 	input.Filters = append(input.Filters, awstypes.Filter{
 		Name:   aws.String("default-for-az"),
 		Values: []string{"false"},
@@ -825,9 +821,12 @@ func (l *subnetListResource) List(ctx context.Context, request list.ListRequest,
 			for _, subnet := range page.Subnets {
 				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), aws.ToString(subnet.SubnetId))
 
-				result := request.NewListResult(ctx)
-
 				tags := keyValueTags(ctx, subnet.Tags)
+				if !filter.Match(tags.KeyTagData("Name").ValueString(), tags) {
+					continue
+				}
+
+				result := request.NewListResult(ctx)
 
 				rd := l.ResourceData()
 				rd.SetId(aws.ToString(subnet.SubnetId))
@@ -31,6 +31,7 @@ import (
 
 // @SDKResource("aws_ecr_repository", name="Repository")
 // @Tags(identifierAttribute="arn")
+// @Permissions(create="ecr:CreateRepository", delete="ecr:DeleteRepository")
 // @IdentityAttribute("name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecr/types;types.Repository")
 // @Testing(preIdentityVersion="v6.10.0")
@@ -143,7 +143,15 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			}),
-			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"ecr:CreateRepository",
+				},
+				Delete: []string{
+					"ecr:DeleteRepository",
+				},
+			},
 			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrName),
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
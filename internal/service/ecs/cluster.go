@@ -6,6 +6,7 @@ package ecs
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -27,7 +29,12 @@ import (
 
 // @SDKResource("aws_ecs_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @CustomImport
+// @ListResource(operation="ListClusters", items="ClusterArns", nameFunc="clusterNameFromARN")
 // @ArnFormat("cluster/{name}")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecs/types;awstypes;awstypes.Cluster")
+// @Testing(preIdentityVersion="v6.23.0")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -328,6 +335,11 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceClusterImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	identitySpec := importer.IdentitySpec(ctx)
+	if err := importer.RegionalSingleParameterized(ctx, d, identitySpec, meta.(importer.AWSClient)); err != nil {
+		return nil, err
+	}
+
 	d.Set(names.AttrName, d.Id())
 
 	region := d.Get(names.AttrRegion).(string)
@@ -385,6 +397,16 @@ func findClusters(ctx context.Context, conn *ecs.Client, input *ecs.DescribeClus
 	return output.Clusters, nil
 }
 
+// clusterNameFromARN returns the name of the cluster with the specified ARN.
+func clusterNameFromARN(v string) string {
+	clusterARN, err := arn.Parse(v)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimPrefix(clusterARN.Resource, "cluster/")
+}
+
 func findClusterByNameOrARN(ctx context.Context, conn *ecs.Client, nameOrARN string) (*awstypes.Cluster, error) {
 	input := &ecs.DescribeClustersInput{
 		Clusters: []string{nameOrARN},
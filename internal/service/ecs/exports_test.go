@@ -16,6 +16,7 @@ var (
 	ResourceTaskSet                  = resourceTaskSet
 
 	ClusterNameFromARN                      = clusterNameFromARN
+	DeploymentRolloutStatus                 = deploymentRolloutStatus
 	FindCapacityProviderByARN               = findCapacityProviderByARN
 	FindClusterByNameOrARN                  = findClusterByNameOrARN
 	FindEffectiveAccountSettingByName       = findEffectiveAccountSettingByName
@@ -27,5 +28,6 @@ var (
 	RoleNameFromARN                         = roleNameFromARN
 	ServiceNameFromARN                      = serviceNameFromARN
 	TaskDefinitionARNStripRevision          = taskDefinitionARNStripRevision
+	TaskFailures                            = taskFailures
 	ValidTaskDefinitionContainerDefinitions = validTaskDefinitionContainerDefinitions
 )
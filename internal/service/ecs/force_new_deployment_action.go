@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ecs_force_new_deployment, name="Force New Deployment")
+func newForceNewDeploymentAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &forceNewDeploymentAction{}, nil
+}
+
+var (
+	_ action.Action = (*forceNewDeploymentAction)(nil)
+)
+
+type forceNewDeploymentAction struct {
+	framework.ActionWithModel[forceNewDeploymentActionModel]
+}
+
+type forceNewDeploymentActionModel struct {
+	framework.WithRegionModel
+	Cluster types.String `tfsdk:"cluster"`
+	Service types.String `tfsdk:"service"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *forceNewDeploymentAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a new deployment of an ECS service and waits for the deployment to complete. The action fails if the deployment fails, including when it is rolled back by the deployment circuit breaker.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Name or ARN of the cluster that the service runs on",
+				Required:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "Name or ARN of the service to redeploy",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the deployment to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *forceNewDeploymentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config forceNewDeploymentActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := config.Cluster.ValueString()
+	service := config.Service.ValueString()
+
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS force new deployment action", map[string]any{
+		"cluster":         cluster,
+		"service":         service,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Forcing new deployment of ECS service %s...", service),
+	})
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		ForceNewDeployment: true,
+		Service:            aws.String(service),
+	}
+
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Force New Deployment",
+			fmt.Sprintf("Could not force new deployment of ECS service %s: %s", service, err),
+		)
+		return
+	}
+
+	primary := findPrimaryTaskSet(output.Service.Deployments)
+	if primary == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Force New Deployment",
+			fmt.Sprintf("ECS service %s has no primary deployment", service),
+		)
+		return
+	}
+	deploymentID := aws.ToString(primary.Id)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s started, waiting for completion...", deploymentID),
+	})
+
+	// Poll for deployment completion using actionwait with backoff strategy.
+	// Deployments can take a long time as tasks are replaced and load balancer targets drain.
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Service], error) {
+		output, err := findServiceNoTagsByTwoPartKey(ctx, conn, service, cluster)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Service]{}, fmt.Errorf("describing service: %w", err)
+		}
+
+		return actionwait.FetchResult[*awstypes.Service]{Status: deploymentRolloutStatus(output, deploymentID), Value: output}, nil
+	}, actionwait.Options[*awstypes.Service]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: time.Minute,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.DeploymentRolloutStateCompleted)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			message := fmt.Sprintf("Deployment %s is currently in state '%s'", deploymentID, fr.Status)
+			if service, ok := fr.Value.(*awstypes.Service); ok {
+				if deployment := findDeploymentByID(service.Deployments, deploymentID); deployment != nil {
+					message += fmt.Sprintf(" with %d of %d tasks running", deployment.RunningCount, deployment.DesiredCount)
+				}
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: message + ", continuing to wait for completion..."})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Deployment Failed",
+				deploymentFailureDetail(fr.Value, deploymentID),
+			)
+		} else if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Deployment to Complete",
+				fmt.Sprintf("Deployment %s of ECS service %s did not complete within %s: %s", deploymentID, service, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Deployment State",
+				fmt.Sprintf("Deployment %s of ECS service %s entered unexpected state: %s", deploymentID, service, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Deployment to Complete",
+				fmt.Sprintf("Error while waiting for deployment %s of ECS service %s to complete: %s", deploymentID, service, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s of ECS service %s completed successfully", deploymentID, service),
+	})
+
+	tflog.Info(ctx, "ECS force new deployment action completed successfully", map[string]any{
+		"deployment_id": deploymentID,
+		"service":       service,
+	})
+}
+
+// deploymentRolloutStatus returns the rollout state of the specified deployment of a service.
+// Rollout state is only reported for services using the rolling update (ECS) deployment controller;
+// for other services the deployment is complete once it is the only deployment and all of its tasks are running.
+func deploymentRolloutStatus(service *awstypes.Service, deploymentID string) actionwait.Status {
+	switch deployment := findDeploymentByID(service.Deployments, deploymentID); {
+	case deployment == nil:
+		// The deployment has been replaced and drained, for example by a deployment circuit breaker rollback.
+		return actionwait.Status(awstypes.DeploymentRolloutStateFailed)
+	case deployment.RolloutState != "":
+		return actionwait.Status(deployment.RolloutState)
+	case len(service.Deployments) == 1 && deployment.RunningCount == deployment.DesiredCount:
+		return actionwait.Status(awstypes.DeploymentRolloutStateCompleted)
+	default:
+		return actionwait.Status(awstypes.DeploymentRolloutStateInProgress)
+	}
+}
+
+func deploymentFailureDetail(service *awstypes.Service, deploymentID string) string {
+	message := fmt.Sprintf("Deployment %s failed", deploymentID)
+	if service == nil {
+		return message
+	}
+
+	message = fmt.Sprintf("Deployment %s of ECS service %s failed", deploymentID, aws.ToString(service.ServiceName))
+
+	if deployment := findDeploymentByID(service.Deployments, deploymentID); deployment != nil {
+		if v := aws.ToString(deployment.RolloutStateReason); v != "" {
+			message += ": " + v
+		}
+	}
+
+	if primary := findPrimaryTaskSet(service.Deployments); primary != nil && aws.ToString(primary.Id) != deploymentID {
+		message += fmt.Sprintf("\n\nThe service was rolled back to deployment %s (task definition %s).", aws.ToString(primary.Id), aws.ToString(primary.TaskDefinition))
+	}
+
+	return message
+}
+
+func findDeploymentByID(deployments []awstypes.Deployment, id string) *awstypes.Deployment {
+	for _, deployment := range deployments {
+		if aws.ToString(deployment.Id) == id {
+			return &deployment
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDeploymentRolloutStatus(t *testing.T) {
+	t.Parallel()
+
+	const deploymentID = "ecs-svc/1234567890"
+
+	testCases := map[string]struct {
+		service *awstypes.Service
+		want    actionwait.Status
+	}{
+		"in progress": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String(deploymentID), Status: aws.String("PRIMARY"), RolloutState: awstypes.DeploymentRolloutStateInProgress},
+					{Id: aws.String("ecs-svc/0"), Status: aws.String("ACTIVE"), RolloutState: awstypes.DeploymentRolloutStateCompleted},
+				},
+			},
+			want: actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		"completed": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String(deploymentID), Status: aws.String("PRIMARY"), RolloutState: awstypes.DeploymentRolloutStateCompleted},
+				},
+			},
+			want: actionwait.Status(awstypes.DeploymentRolloutStateCompleted),
+		},
+		"circuit breaker rollback": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String("ecs-svc/0"), Status: aws.String("PRIMARY"), RolloutState: awstypes.DeploymentRolloutStateInProgress},
+					{Id: aws.String(deploymentID), Status: aws.String("ACTIVE"), RolloutState: awstypes.DeploymentRolloutStateFailed},
+				},
+			},
+			want: actionwait.Status(awstypes.DeploymentRolloutStateFailed),
+		},
+		"replaced": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String("ecs-svc/0"), Status: aws.String("PRIMARY"), RolloutState: awstypes.DeploymentRolloutStateCompleted},
+				},
+			},
+			want: actionwait.Status(awstypes.DeploymentRolloutStateFailed),
+		},
+		"no rollout state pending": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String(deploymentID), Status: aws.String("PRIMARY"), DesiredCount: 2, RunningCount: 1},
+				},
+			},
+			want: actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		"no rollout state steady": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String(deploymentID), Status: aws.String("PRIMARY"), DesiredCount: 2, RunningCount: 2},
+				},
+			},
+			want: actionwait.Status(awstypes.DeploymentRolloutStateCompleted),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfecs.DeploymentRolloutStatus(testCase.service, deploymentID), testCase.want; got != want {
+				t.Errorf("DeploymentRolloutStatus() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAccECSForceNewDeploymentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_launchTypeFargate(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+				),
+			},
+			{
+				Config: testAccForceNewDeploymentActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckForceNewDeploymentActionCompleted(ctx, resourceName, &service),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckForceNewDeploymentActionCompleted(ctx context.Context, n string, before *awstypes.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
+
+		after, err := tfecs.FindServiceNoTagsByTwoPartKey(ctx, conn, rs.Primary.ID, rs.Primary.Attributes["cluster"])
+		if err != nil {
+			return err
+		}
+
+		if len(after.Deployments) != 1 {
+			return fmt.Errorf("ECS Service (%s) has %d deployments, want 1", rs.Primary.ID, len(after.Deployments))
+		}
+
+		deployment := after.Deployments[0]
+		if aws.ToString(deployment.Id) == aws.ToString(before.Deployments[0].Id) {
+			return fmt.Errorf("ECS Service (%s) was not redeployed", rs.Primary.ID)
+		}
+
+		if deployment.RolloutState != awstypes.DeploymentRolloutStateCompleted {
+			return fmt.Errorf("ECS Service (%s) deployment rollout state = %s, want %s", rs.Primary.ID, deployment.RolloutState, awstypes.DeploymentRolloutStateCompleted)
+		}
+
+		return nil
+	}
+}
+
+func testAccForceNewDeploymentActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargate(rName, true), `
+action "aws_ecs_force_new_deployment" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = aws_ecs_service.test.name
+    timeout = 1200
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_force_new_deployment.test]
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,436 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// runTaskPollInterval defines polling cadence for the run task action.
+	runTaskPollInterval = 10 * time.Second
+
+	taskStatusStopped = "STOPPED"
+)
+
+// @Action(aws_ecs_run_task, name="Run Task")
+func newRunTaskAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &runTaskAction{}, nil
+}
+
+var (
+	_ action.Action = (*runTaskAction)(nil)
+)
+
+type runTaskAction struct {
+	framework.ActionWithModel[runTaskActionModel]
+}
+
+type runTaskActionModel struct {
+	framework.WithRegionModel
+	Cluster              types.String                                                      `tfsdk:"cluster"`
+	ContainerOverrides   fwtypes.ListNestedObjectValueOf[runTaskContainerOverrideModel]    `tfsdk:"container_overrides"`
+	LaunchType           fwtypes.StringEnum[awstypes.LaunchType]                           `tfsdk:"launch_type"`
+	NetworkConfiguration fwtypes.ListNestedObjectValueOf[runTaskNetworkConfigurationModel] `tfsdk:"network_configuration"`
+	PlatformVersion      types.String                                                      `tfsdk:"platform_version"`
+	StartedBy            types.String                                                      `tfsdk:"started_by"`
+	TaskDefinition       types.String                                                      `tfsdk:"task_definition"`
+	Timeout              types.Int64                                                       `tfsdk:"timeout"`
+}
+
+type runTaskContainerOverrideModel struct {
+	Command     fwtypes.ListOfString `tfsdk:"command"`
+	Environment fwtypes.MapOfString  `tfsdk:"environment"`
+	Name        types.String         `tfsdk:"name"`
+}
+
+type runTaskNetworkConfigurationModel struct {
+	AssignPublicIP types.Bool           `tfsdk:"assign_public_ip"`
+	SecurityGroups fwtypes.ListOfString `tfsdk:"security_groups"`
+	Subnets        fwtypes.ListOfString `tfsdk:"subnets"`
+}
+
+func (a *runTaskAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a one-off ECS task and waits for it to stop. The action fails if the task fails to start or any essential container exits with a non-zero exit code.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Name or ARN of the cluster to run the task on",
+				Required:    true,
+			},
+			"launch_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.LaunchType](),
+				Description: "Launch type on which to run the task. Valid values are 'EC2', 'FARGATE', 'EXTERNAL', and 'MANAGED_INSTANCES'.",
+				Optional:    true,
+			},
+			"platform_version": schema.StringAttribute{
+				Description: "Platform version the task uses. Only applies to tasks run on Fargate.",
+				Optional:    true,
+			},
+			"started_by": schema.StringAttribute{
+				Description: "Optional tag specified when the task is started, used to identify the task",
+				Optional:    true,
+			},
+			"task_definition": schema.StringAttribute{
+				Description: "Family and revision (family:revision), family, or full ARN of the task definition to run",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the task to stop (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"container_overrides": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskContainerOverrideModel](ctx),
+				Description: "Container overrides for the task",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Description: "Command to send to the container that overrides the default command from the Docker image or the task definition",
+							Optional:    true,
+						},
+						names.AttrEnvironment: schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Description: "Environment variables to send to the container, in addition to those in the task definition",
+							Optional:    true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Description: "Name of the container that receives the override",
+							Required:    true,
+						},
+					},
+				},
+			},
+			names.AttrNetworkConfiguration: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskNetworkConfigurationModel](ctx),
+				Description: "Network configuration for the task. Required for task definitions that use the awsvpc network mode.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"assign_public_ip": schema.BoolAttribute{
+							Description: "Whether the task's elastic network interface receives a public IP address",
+							Optional:    true,
+						},
+						names.AttrSecurityGroups: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Description: "IDs of the security groups associated with the task",
+							Optional:    true,
+						},
+						names.AttrSubnets: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Description: "IDs of the subnets associated with the task",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *runTaskAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config runTaskActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := config.Cluster.ValueString()
+	taskDefinition := config.TaskDefinition.ValueString()
+
+	timeout := 3600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS run task action", map[string]any{
+		"cluster":         cluster,
+		"task_definition": taskDefinition,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Running task from task definition %s on ECS cluster %s...", taskDefinition, cluster),
+	})
+
+	input := ecs.RunTaskInput{
+		Cluster:         aws.String(cluster),
+		LaunchType:      config.LaunchType.ValueEnum(),
+		PlatformVersion: fwflex.StringFromFramework(ctx, config.PlatformVersion),
+		StartedBy:       fwflex.StringFromFramework(ctx, config.StartedBy),
+		TaskDefinition:  aws.String(taskDefinition),
+	}
+
+	containerOverrides, diags := config.ContainerOverrides.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(containerOverrides) > 0 {
+		input.Overrides = &awstypes.TaskOverride{}
+
+		for _, containerOverride := range containerOverrides {
+			apiObject := awstypes.ContainerOverride{
+				Command: fwflex.ExpandFrameworkStringValueList(ctx, containerOverride.Command),
+				Name:    containerOverride.Name.ValueStringPointer(),
+			}
+
+			for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, containerOverride.Environment) {
+				apiObject.Environment = append(apiObject.Environment, awstypes.KeyValuePair{
+					Name:  aws.String(k),
+					Value: aws.String(v),
+				})
+			}
+
+			input.Overrides.ContainerOverrides = append(input.Overrides.ContainerOverrides, apiObject)
+		}
+	}
+
+	networkConfiguration, diags := config.NetworkConfiguration.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if networkConfiguration != nil {
+		assignPublicIP := awstypes.AssignPublicIpDisabled
+		if networkConfiguration.AssignPublicIP.ValueBool() {
+			assignPublicIP = awstypes.AssignPublicIpEnabled
+		}
+
+		input.NetworkConfiguration = &awstypes.NetworkConfiguration{
+			AwsvpcConfiguration: &awstypes.AwsVpcConfiguration{
+				AssignPublicIp: assignPublicIP,
+				SecurityGroups: fwflex.ExpandFrameworkStringValueList(ctx, networkConfiguration.SecurityGroups),
+				Subnets:        fwflex.ExpandFrameworkStringValueList(ctx, networkConfiguration.Subnets),
+			},
+		}
+	}
+
+	output, err := conn.RunTask(ctx, &input)
+	if err == nil && len(output.Failures) > 0 {
+		err = failureError(&output.Failures[0])
+	}
+	if err == nil && len(output.Tasks) == 0 {
+		err = tfresource.NewEmptyResultError(&input)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Run Task",
+			fmt.Sprintf("Could not run task from task definition %s on ECS cluster %s: %s", taskDefinition, cluster, err),
+		)
+		return
+	}
+
+	taskARN := aws.ToString(output.Tasks[0].TaskArn)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Task %s started, waiting for it to stop...", taskARN),
+	})
+
+	// Wait for the task to stop with periodic progress updates using actionwait.
+	// Tasks move through a fixed sequence of lifecycle states, so a fixed interval is sufficient.
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Task], error) {
+		task, err := findTaskByTwoPartKey(ctx, conn, taskARN, cluster)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Task]{}, fmt.Errorf("describing task: %w", err)
+		}
+
+		return actionwait.FetchResult[*awstypes.Task]{Status: actionwait.Status(aws.ToString(task.LastStatus)), Value: task}, nil
+	}, actionwait.Options[*awstypes.Task]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(runTaskPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{taskStatusStopped},
+		TransitionalStates: []actionwait.Status{
+			"PROVISIONING",
+			"PENDING",
+			"ACTIVATING",
+			"RUNNING",
+			"DEACTIVATING",
+			"STOPPING",
+			"DEPROVISIONING",
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("ECS task %s is currently in status '%s', continuing to wait for 'STOPPED'...", taskARN, fr.Status),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Task to Stop",
+				fmt.Sprintf("ECS task %s did not stop within %s: %s", taskARN, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Task Status",
+				fmt.Sprintf("ECS task %s entered unexpected status while running: %s", taskARN, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Task to Stop",
+				fmt.Sprintf("Error while waiting for ECS task %s to stop: %s", taskARN, err),
+			)
+		}
+		return
+	}
+
+	task := fr.Value
+
+	// Report each container's exit code.
+	for _, container := range task.Containers {
+		if container.ExitCode == nil {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Container %s did not exit normally: %s", aws.ToString(container.Name), aws.ToString(container.Reason)),
+			})
+		} else {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Container %s exited with code %d", aws.ToString(container.Name), aws.ToInt32(container.ExitCode)),
+			})
+		}
+	}
+
+	essential, err := findEssentialContainersByTaskDefinitionARN(ctx, conn, aws.ToString(task.TaskDefinitionArn))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Task Definition",
+			fmt.Sprintf("Could not describe task definition %s: %s", aws.ToString(task.TaskDefinitionArn), err),
+		)
+		return
+	}
+
+	if failures := taskFailures(task, essential); len(failures) > 0 {
+		resp.Diagnostics.AddError(
+			"Task Failed",
+			fmt.Sprintf("ECS task %s stopped (%s: %s):\n\n%s", taskARN, task.StopCode, aws.ToString(task.StoppedReason), strings.Join(failures, "\n")),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("ECS task %s completed successfully", taskARN),
+	})
+
+	tflog.Info(ctx, "ECS run task action completed successfully", map[string]any{
+		"task_arn": taskARN,
+	})
+}
+
+// findEssentialContainersByTaskDefinitionARN returns whether each container in the specified task definition is essential.
+func findEssentialContainersByTaskDefinitionARN(ctx context.Context, conn *ecs.Client, taskDefinitionARN string) (map[string]bool, error) {
+	taskDefinition, _, err := findTaskDefinitionByFamilyOrARN(ctx, conn, taskDefinitionARN)
+	if err != nil {
+		return nil, err
+	}
+
+	essential := make(map[string]bool)
+	for _, v := range taskDefinition.ContainerDefinitions {
+		// Containers are essential by default.
+		essential[aws.ToString(v.Name)] = aws.ToBool(v.Essential) || v.Essential == nil
+	}
+
+	return essential, nil
+}
+
+// taskFailures returns a description of each essential container in a stopped task that did not exit successfully.
+// A task that failed to start is always a failure.
+func taskFailures(task *awstypes.Task, essential map[string]bool) []string {
+	var failures []string
+
+	for _, container := range task.Containers {
+		name := aws.ToString(container.Name)
+		if !essential[name] {
+			continue
+		}
+
+		switch {
+		case container.ExitCode == nil:
+			failures = append(failures, fmt.Sprintf("container %s did not exit normally: %s", name, aws.ToString(container.Reason)))
+		case aws.ToInt32(container.ExitCode) != 0:
+			failures = append(failures, fmt.Sprintf("container %s exited with code %d", name, aws.ToInt32(container.ExitCode)))
+		}
+	}
+
+	if len(failures) == 0 && task.StopCode == awstypes.TaskStopCodeTaskFailedToStart {
+		failures = append(failures, "task failed to start")
+	}
+
+	return failures
+}
+
+func findTaskByTwoPartKey(ctx context.Context, conn *ecs.Client, taskARN, clusterNameOrARN string) (*awstypes.Task, error) {
+	input := &ecs.DescribeTasksInput{
+		Cluster: aws.String(clusterNameOrARN),
+		Tasks:   []string{taskARN},
+	}
+
+	output, err := conn.DescribeTasks(ctx, input)
+
+	if errs.IsA[*awstypes.ClusterNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Failures {
+		if aws.ToString(v.Reason) == failureReasonMissing {
+			return nil, &retry.NotFoundError{
+				LastError:   failureError(&v),
+				LastRequest: input,
+			}
+		}
+	}
+
+	return tfresource.AssertSingleValueResult(output.Tasks)
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTaskFailures(t *testing.T) {
+	t.Parallel()
+
+	essential := map[string]bool{
+		"app":     true,
+		"sidecar": false,
+	}
+
+	testCases := map[string]struct {
+		task *awstypes.Task
+		want []string
+	}{
+		"success": {
+			task: &awstypes.Task{
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int32(0)},
+					{Name: aws.String("sidecar"), ExitCode: aws.Int32(143)},
+				},
+				StopCode: awstypes.TaskStopCodeEssentialContainerExited,
+			},
+		},
+		"non-zero exit code": {
+			task: &awstypes.Task{
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int32(3)},
+					{Name: aws.String("sidecar"), ExitCode: aws.Int32(0)},
+				},
+				StopCode: awstypes.TaskStopCodeEssentialContainerExited,
+			},
+			want: []string{"container app exited with code 3"},
+		},
+		"no exit code": {
+			task: &awstypes.Task{
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), Reason: aws.String("CannotPullContainerError")},
+				},
+				StopCode: awstypes.TaskStopCodeTaskFailedToStart,
+			},
+			want: []string{"container app did not exit normally: CannotPullContainerError"},
+		},
+		"failed to start": {
+			task: &awstypes.Task{
+				StopCode: awstypes.TaskStopCodeTaskFailedToStart,
+			},
+			want: []string{"task failed to start"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfecs.TaskFailures(testCase.task, essential)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccECSRunTaskAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunTaskActionConfig_basic(rName, "exit 0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunTaskActionExitCode(ctx, "aws_ecs_cluster.test", rName, 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSRunTaskAction_failure(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRunTaskActionConfig_basic(rName, "exit 3"),
+				ExpectError: regexp.MustCompile(`container test exited with code 3`),
+			},
+		},
+	})
+}
+
+func testAccCheckRunTaskActionExitCode(ctx context.Context, n, startedBy string, want int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
+
+		cluster := rs.Primary.Attributes[names.AttrARN]
+		listInput := ecs.ListTasksInput{
+			Cluster:       aws.String(cluster),
+			DesiredStatus: awstypes.DesiredStatusStopped,
+			StartedBy:     aws.String(startedBy),
+		}
+		listOutput, err := conn.ListTasks(ctx, &listInput)
+		if err != nil {
+			return err
+		}
+
+		if len(listOutput.TaskArns) != 1 {
+			return fmt.Errorf("expected 1 stopped ECS task started by %s, found %d", startedBy, len(listOutput.TaskArns))
+		}
+
+		describeInput := ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   listOutput.TaskArns,
+		}
+		describeOutput, err := conn.DescribeTasks(ctx, &describeInput)
+		if err != nil {
+			return err
+		}
+
+		for _, task := range describeOutput.Tasks {
+			for _, container := range task.Containers {
+				if got := aws.ToInt32(container.ExitCode); got != want {
+					return fmt.Errorf("container %s exit code = %d, want %d", aws.ToString(container.Name), got, want)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccRunTaskActionConfig_basic(rName, command string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "run" {
+  family                   = "%[1]s-run"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([
+    {
+      name      = "test"
+      image     = "public.ecr.aws/docker/library/busybox:latest"
+      essential = true
+      command   = ["sh", "-c", "sleep 60"]
+    }
+  ])
+}
+
+action "aws_ecs_run_task" "test" {
+  config {
+    cluster         = aws_ecs_cluster.test.name
+    task_definition = aws_ecs_task_definition.run.arn
+    launch_type     = "FARGATE"
+    started_by      = %[1]q
+    timeout         = 900
+
+    network_configuration {
+      subnets          = aws_subnet.test[*].id
+      security_groups  = [aws_security_group.test[0].id]
+      assign_public_ip = true
+    }
+
+    container_overrides {
+      name    = "test"
+      command = ["sh", "-c", %[2]q]
+
+      environment = {
+        TF_ACC = "1"
+      }
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_run_task.test]
+    }
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+`, rName, command))
+}
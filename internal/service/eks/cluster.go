@@ -32,6 +32,12 @@ import (
 
 // @SDKResource("aws_eks_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @ListResource(operation="ListClusters", items="Clusters", idIsName=true)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/eks/types;types.Cluster")
+// @Testing(idAttrDuplicates="name")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="v6.23.0")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -39,10 +45,6 @@ func resourceCluster() *schema.Resource {
 		UpdateWithoutTimeout: resourceClusterUpdate,
 		DeleteWithoutTimeout: resourceClusterDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -46,6 +46,7 @@ const (
 
 // @SDKResource("aws_iam_policy", name="Policy")
 // @Tags(identifierAttribute="arn", resourceType="Policy")
+// @Permissions(create="iam:CreatePolicy", update="iam:CreatePolicyVersion", delete="iam:DeletePolicy")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Policy")
 // @ArnIdentity
 // @Testing(preIdentityVersion="v6.4.0")
@@ -55,6 +55,7 @@ const (
 
 // @SDKResource("aws_iam_role", name="Role")
 // @Tags(identifierAttribute="name", resourceType="Role")
+// @Permissions(create="iam:CreateRole", delete="iam:DeleteRole")
 // @IdentityAttribute("name")
 // @CustomImport
 // @V60SDKv2Fix
@@ -261,6 +261,17 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				ResourceType:        "Policy",
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"iam:CreatePolicy",
+				},
+				Update: []string{
+					"iam:CreatePolicyVersion",
+				},
+				Delete: []string{
+					"iam:DeletePolicy",
+				},
+			},
 			Identity: inttypes.GlobalARNIdentity(
 				inttypes.WithIdentityDuplicateAttrs(names.AttrID),
 			),
@@ -283,6 +294,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				ResourceType:        "Role",
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"iam:CreateRole",
+				},
+				Delete: []string{
+					"iam:DeleteRole",
+				},
+			},
 			Identity: inttypes.GlobalSingleParameterIdentity(names.AttrName,
 				inttypes.WithV6_0SDKv2Fix(),
 			),
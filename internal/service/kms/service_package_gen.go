@@ -129,7 +129,15 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			}),
-			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"kms:CreateKey",
+				},
+				Delete: []string{
+					"kms:ScheduleKeyDeletion",
+				},
+			},
 			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrID),
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
@@ -43,6 +43,8 @@ const (
 
 // @SDKResource("aws_lambda_function", name="Function")
 // @Tags(identifierAttribute="arn")
+// @Permissions(create="lambda:CreateFunction;iam:PassRole", delete="lambda:DeleteFunction")
+// @ListResource(operation="ListFunctions", items="Functions", id="FunctionName", idIsName=true, nameAttribute="function_name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetFunctionOutput")
 // @Testing(importIgnore="filename;last_modified;publish")
 // @IdentityAttribute("function_name")
@@ -35,6 +35,7 @@ import (
 
 // @SDKResource("aws_cloudwatch_log_group", name="Log Group")
 // @Tags(identifierAttribute="arn")
+// @Permissions(create="logs:CreateLogGroup", delete="logs:DeleteLogGroup")
 // @IdentityAttribute("name")
 // @Testing(destroyTakesT=true)
 // @Testing(existsTakesT=true)
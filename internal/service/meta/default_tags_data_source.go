@@ -46,7 +46,8 @@ func (d *defaultTagsDataSource) Read(ctx context.Context, request datasource.Rea
 		return
 	}
 
-	defaultTagsConfig := d.Meta().DefaultTagsConfig(ctx)
+	// Return the provider's base default tags, not those resolved for this data source's own resource type.
+	defaultTagsConfig := d.Meta().ProviderDefaultTagsConfig(ctx)
 	ignoreTagsConfig := d.Meta().IgnoreTagsConfig(ctx)
 	tags := defaultTagsConfig.GetTags()
 
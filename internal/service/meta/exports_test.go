@@ -7,4 +7,9 @@ package meta
 var (
 	FindRegionByEC2Endpoint = findRegionByEC2Endpoint
 	FindRegionByName        = findRegionByName
+	TaggingTargetCandidates = taggingTargetCandidates
+)
+
+type (
+	TaggingTargetCandidate = taggingTargetCandidate
 )
@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unique"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_resource_tags", name="Resource Tags")
+// @Region(overrideEnabled=false)
+// @AssumeRoleOverride
+func newResourceTagsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTagsResource{}
+
+	return r, nil
+}
+
+type resourceTagsResource struct {
+	framework.ResourceWithModel[resourceTagsResourceModel]
+}
+
+func (r *resourceTagsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags: tftags.TagsAttributeRequired(),
+		},
+	}
+}
+
+func (r *resourceTagsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceARN := data.ResourceARN.ValueString()
+	ctx, target, err := taggingContext(ctx, r.Meta(), resourceARN)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating resource (%s) tags", resourceARN), err.Error())
+
+		return
+	}
+
+	if err := target.updateTags(ctx, r.Meta(), nil, tftags.New(ctx, data.Tags)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating resource (%s) tags", resourceARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTagsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceARN := data.ResourceARN.ValueString()
+	ctx, target, err := taggingContext(ctx, r.Meta(), resourceARN)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading resource (%s) tags", resourceARN), err.Error())
+
+		return
+	}
+
+	tags, err := target.listTags(ctx, r.Meta())
+
+	if isResourceNotFoundError(err) {
+		tflog.Warn(ctx, "Resource not found, removing tags from state", map[string]any{
+			names.AttrResourceARN: resourceARN,
+		})
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading resource (%s) tags", resourceARN), err.Error())
+
+		return
+	}
+
+	// Only the tag keys managed by this resource are kept.
+	// After import, all tags other than AWS-reserved tags are managed.
+	tags = tags.IgnoreAWS()
+	if !data.Tags.IsNull() {
+		tags = tags.Only(tftags.New(ctx, data.Tags))
+	}
+	data.Tags = tftags.FlattenStringValueMap(ctx, tags.Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTagsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceTagsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceARN := new.ResourceARN.ValueString()
+	ctx, target, err := taggingContext(ctx, r.Meta(), resourceARN)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating resource (%s) tags", resourceARN), err.Error())
+
+		return
+	}
+
+	if err := target.updateTags(ctx, r.Meta(), tftags.New(ctx, old.Tags), tftags.New(ctx, new.Tags)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating resource (%s) tags", resourceARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceTagsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceARN := data.ResourceARN.ValueString()
+	ctx, target, err := taggingContext(ctx, r.Meta(), resourceARN)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting resource (%s) tags", resourceARN), err.Error())
+
+		return
+	}
+
+	err = target.updateTags(ctx, r.Meta(), tftags.New(ctx, data.Tags), nil)
+
+	if isResourceNotFoundError(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting resource (%s) tags", resourceARN), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceTagsResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrResourceARN), request, response)
+}
+
+// taggingContext returns the tagging target for the specified ARN, along with a Context in which
+// the target service package's API clients are configured for the ARN's Region.
+func taggingContext(ctx context.Context, c *conns.AWSClient, resourceARN string) (context.Context, *taggingTarget, error) {
+	arn, err := arn.Parse(resourceARN)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	target, err := findTaggingTarget(ctx, c, arn)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		roleARN := inContext.OverrideAssumeRoleARN()
+		ctx = conns.NewResourceContext(ctx, target.servicePackage.ServicePackageName(), inContext.ResourceName(), inContext.TypeName(), arn.Region)
+		if roleARN != "" {
+			ctx = conns.WithOverrideAssumeRoleARN(ctx, roleARN)
+		}
+	}
+	ctx = tftags.NewContext(ctx, nil, nil, nil)
+
+	return ctx, target, nil
+}
+
+// taggingTarget is a resource as identified in a service package's generic tagging methods.
+type taggingTarget struct {
+	servicePackage conns.ServicePackage
+	identifier     string
+	resourceType   string
+}
+
+func (t *taggingTarget) listTags(ctx context.Context, meta any) (tftags.KeyValueTags, error) {
+	var err error
+	if t.resourceType == "" {
+		err = t.servicePackage.(tftags.ServiceTagLister).ListTags(ctx, meta, t.identifier) // Sets tags in Context
+	} else {
+		err = t.servicePackage.(tftags.ResourceTypeTagLister).ListTags(ctx, meta, t.identifier, t.resourceType) // Sets tags in Context
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.TagsOut.UnwrapOrDefault(), nil
+	}
+
+	return tftags.New(ctx, nil), nil
+}
+
+func (t *taggingTarget) updateTags(ctx context.Context, meta any, oldTags, newTags tftags.KeyValueTags) error {
+	if t.resourceType == "" {
+		return t.servicePackage.(tftags.ServiceTagUpdater).UpdateTags(ctx, meta, t.identifier, oldTags, newTags)
+	}
+
+	return t.servicePackage.(tftags.ResourceTypeTagUpdater).UpdateTags(ctx, meta, t.identifier, t.resourceType, oldTags, newTags)
+}
+
+// taggingTargetCandidate is a possible tagging target for an ARN.
+type taggingTargetCandidate struct {
+	ServicePackageName string
+	Identifier         string
+	ResourceType       string
+}
+
+// findTaggingTarget returns the first candidate tagging target for the specified ARN whose service package implements
+// the corresponding generic tagging methods. Candidates identified by ARN are only considered for service packages
+// whose resources are tagged by ARN.
+func findTaggingTarget(ctx context.Context, c *conns.AWSClient, arn arn.ARN) (*taggingTarget, error) {
+	candidates, err := taggingTargetCandidates(arn)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		sp := c.ServicePackage(ctx, candidate.ServicePackageName)
+		if sp == nil {
+			continue
+		}
+
+		if candidate.ResourceType == "" {
+			if _, ok := sp.(tftags.ServiceTagLister); !ok {
+				continue
+			}
+			if _, ok := sp.(tftags.ServiceTagUpdater); !ok {
+				continue
+			}
+			if candidate.Identifier == arn.String() && !isTaggedByARN(ctx, sp) {
+				continue
+			}
+		} else {
+			if _, ok := sp.(tftags.ResourceTypeTagLister); !ok {
+				continue
+			}
+			if _, ok := sp.(tftags.ResourceTypeTagUpdater); !ok {
+				continue
+			}
+		}
+
+		return &taggingTarget{
+			servicePackage: sp,
+			identifier:     candidate.Identifier,
+			resourceType:   candidate.ResourceType,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("tagging resources with ARNs in the %q namespace is not supported", arn.Service)
+}
+
+// taggingTargetCandidates returns the possible tagging targets for the specified ARN, in order of preference.
+func taggingTargetCandidates(arn arn.ARN) ([]taggingTargetCandidate, error) {
+	switch arn.Service {
+	case "ec2":
+		// EC2 resources are tagged by ID.
+		_, id, ok := strings.Cut(arn.Resource, "/")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("unsupported EC2 ARN resource: %s", arn.Resource)
+		}
+
+		return []taggingTargetCandidate{{ServicePackageName: names.EC2, Identifier: id}}, nil
+
+	case "iam":
+		resourceType, name, ok := strings.Cut(arn.Resource, "/")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("unsupported IAM ARN resource: %s", arn.Resource)
+		}
+		// Remove any path.
+		name = name[strings.LastIndex(name, "/")+1:]
+
+		candidate := taggingTargetCandidate{ServicePackageName: names.IAM}
+		switch resourceType {
+		case "instance-profile":
+			candidate.ResourceType, candidate.Identifier = "InstanceProfile", name
+		case "mfa":
+			candidate.ResourceType, candidate.Identifier = "VirtualMFADevice", arn.String()
+		case "oidc-provider":
+			candidate.ResourceType, candidate.Identifier = "OIDCProvider", arn.String()
+		case "policy":
+			candidate.ResourceType, candidate.Identifier = "Policy", arn.String()
+		case "role":
+			candidate.ResourceType, candidate.Identifier = "Role", name
+		case "saml-provider":
+			candidate.ResourceType, candidate.Identifier = "SAMLProvider", arn.String()
+		case "server-certificate":
+			candidate.ResourceType, candidate.Identifier = "ServerCertificate", name
+		case "user":
+			candidate.ResourceType, candidate.Identifier = "User", name
+		default:
+			return nil, fmt.Errorf("unsupported IAM ARN resource: %s", arn.Resource)
+		}
+
+		return []taggingTargetCandidate{candidate}, nil
+
+	case "s3":
+		if strings.Contains(arn.Resource, "/") {
+			return []taggingTargetCandidate{{ServicePackageName: names.S3, Identifier: arn.String(), ResourceType: "Object"}}, nil
+		}
+
+		return []taggingTargetCandidate{{ServicePackageName: names.S3, Identifier: arn.Resource, ResourceType: "Bucket"}}, nil
+	}
+
+	servicePackageNames := names.ProviderPackagesForARNNamespace(arn.Service)
+	if len(servicePackageNames) == 0 {
+		return nil, fmt.Errorf("unsupported ARN namespace: %s", arn.Service)
+	}
+
+	// Prefer the service package named for the ARN namespace.
+	slices.SortStableFunc(servicePackageNames, func(a, b string) int {
+		switch {
+		case a == arn.Service:
+			return -1
+		case b == arn.Service:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	candidates := make([]taggingTargetCandidate, 0, len(servicePackageNames))
+	for _, servicePackageName := range servicePackageNames {
+		candidates = append(candidates, taggingTargetCandidate{ServicePackageName: servicePackageName, Identifier: arn.String()})
+	}
+
+	return candidates, nil
+}
+
+// isTaggedByARN returns whether any of the service package's resources are tagged by ARN
+// using the service package's generic tagging methods.
+func isTaggedByARN(ctx context.Context, sp conns.ServicePackage) bool {
+	isTaggedByARN := func(v unique.Handle[inttypes.ServicePackageResourceTags]) bool {
+		return !tfunique.IsHandleNil(v) && v.Value().IdentifierAttribute == names.AttrARN && v.Value().ResourceType == ""
+	}
+
+	for _, v := range sp.FrameworkResources(ctx) {
+		if isTaggedByARN(v.Tags) {
+			return true
+		}
+	}
+	for _, v := range sp.SDKResources(ctx) {
+		if isTaggedByARN(v.Tags) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isResourceNotFoundError returns whether the error returned from a service's tagging API indicates that the tagged resource does not exist.
+func isResourceNotFoundError(err error) bool {
+	return tfawserr.ErrCodeContains(err, "NotFound") || tfawserr.ErrCodeContains(err, "NoSuch")
+}
+
+type resourceTagsResourceModel struct {
+	framework.WithAssumeRoleARNModel
+	ResourceARN fwtypes.ARN `tfsdk:"resource_arn"`
+	Tags        tftags.Map  `tfsdk:"tags"`
+}
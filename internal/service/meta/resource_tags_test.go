@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTaggingTargetCandidates(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn     string
+		want    []tfmeta.TaggingTargetCandidate
+		wantErr bool
+	}{
+		"ARN identified": {
+			arn: "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			want: []tfmeta.TaggingTargetCandidate{
+				{ServicePackageName: names.SNS, Identifier: "arn:aws:sns:us-west-2:123456789012:example"}, //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		"shared namespace": {
+			arn: "arn:aws:rds:us-west-2:123456789012:db:example", //lintignore:AWSAT003,AWSAT005
+			want: []tfmeta.TaggingTargetCandidate{
+				{ServicePackageName: names.RDS, Identifier: "arn:aws:rds:us-west-2:123456789012:db:example"},     //lintignore:AWSAT003,AWSAT005
+				{ServicePackageName: names.DocDB, Identifier: "arn:aws:rds:us-west-2:123456789012:db:example"},   //lintignore:AWSAT003,AWSAT005
+				{ServicePackageName: names.Neptune, Identifier: "arn:aws:rds:us-west-2:123456789012:db:example"}, //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		"EC2": {
+			arn: "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			want: []tfmeta.TaggingTargetCandidate{
+				{ServicePackageName: names.EC2, Identifier: "vpc-12345678"},
+			},
+		},
+		"IAM role with path": {
+			arn: "arn:aws:iam::123456789012:role/service-role/example", //lintignore:AWSAT005
+			want: []tfmeta.TaggingTargetCandidate{
+				{ServicePackageName: names.IAM, Identifier: "example", ResourceType: "Role"},
+			},
+		},
+		"IAM policy": {
+			arn: "arn:aws:iam::123456789012:policy/example", //lintignore:AWSAT005
+			want: []tfmeta.TaggingTargetCandidate{
+				{ServicePackageName: names.IAM, Identifier: "arn:aws:iam::123456789012:policy/example", ResourceType: "Policy"}, //lintignore:AWSAT005
+			},
+		},
+		"IAM unsupported": {
+			arn:     "arn:aws:iam::123456789012:group/example", //lintignore:AWSAT005
+			wantErr: true,
+		},
+		"S3 bucket": {
+			arn: "arn:aws:s3:::example", //lintignore:AWSAT005
+			want: []tfmeta.TaggingTargetCandidate{
+				{ServicePackageName: names.S3, Identifier: "example", ResourceType: "Bucket"},
+			},
+		},
+		"S3 object": {
+			arn: "arn:aws:s3:::example/key", //lintignore:AWSAT005
+			want: []tfmeta.TaggingTargetCandidate{
+				{ServicePackageName: names.S3, Identifier: "arn:aws:s3:::example/key", ResourceType: "Object"}, //lintignore:AWSAT005
+			},
+		},
+		"unknown namespace": {
+			arn:     "arn:aws:nosuchservice:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := arn.Parse(testCase.arn)
+			if err != nil {
+				t.Fatalf("parsing ARN: %s", err)
+			}
+
+			got, err := tfmeta.TaggingTargetCandidates(v)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error: got %v, want error %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccMetaResourceTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_snsTopic(rName, acctest.CtKey1, acctest.CtValue1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrResourceARN,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrResourceARN),
+			},
+			{
+				Config: testAccResourceTagsConfig_snsTopic(rName, acctest.CtKey1, acctest.CtValue1Updated),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1Updated),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccMetaResourceTags_existingTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resource_tags.test"
+	topicResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_snsTopicExistingTags(rName, acctest.CtKey2, acctest.CtValue2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+			},
+			{
+				// The topic's own tags are unaffected.
+				Config: testAccResourceTagsConfig_snsTopicExistingTags(rName, acctest.CtKey2, acctest.CtValue2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(topicResourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccMetaResourceTags_ec2(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_ec2VPC(acctest.CtKey1, acctest.CtValue1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccMetaResourceTags_iamRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_iamRole(rName, acctest.CtKey1, acctest.CtValue1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccResourceTagsConfig_snsTopic(rName, tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_sns_topic.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey, tagValue)
+}
+
+func testAccResourceTagsConfig_snsTopicExistingTags(rName, tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  lifecycle {
+    ignore_changes = [tags["%[4]s"], tags_all["%[4]s"]]
+  }
+}
+
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_sns_topic.test.arn
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, rName, acctest.CtKey1, acctest.CtValue1, tagKey, tagValue)
+}
+
+func testAccResourceTagsConfig_ec2VPC(tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_vpc.test.arn
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey, tagValue)
+}
+
+func testAccResourceTagsConfig_iamRole(rName, tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/test/"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_iam_role.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey, tagValue)
+}
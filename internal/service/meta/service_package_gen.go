@@ -72,7 +72,22 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
-	return []*inttypes.ServicePackageFrameworkResource{}
+	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newResourceTagsResource,
+			TypeName: "aws_resource_tags",
+			Name:     "Resource Tags",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+			Account:  unique.Make(inttypes.ResourceAccountAssumeRoleOverrideEnabled()),
+		},
+		{
+			Factory:  newTagsExclusiveResource,
+			TypeName: "aws_tags_exclusive",
+			Name:     "Tags Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+			Account:  unique.Make(inttypes.ResourceAccountAssumeRoleOverrideEnabled()),
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
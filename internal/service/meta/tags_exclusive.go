@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_tags_exclusive", name="Tags Exclusive")
+// @Region(overrideEnabled=false)
+// @AssumeRoleOverride
+func newTagsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &tagsExclusiveResource{}
+
+	return r, nil
+}
+
+type tagsExclusiveResource struct {
+	framework.ResourceWithModel[tagsExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *tagsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags: tftags.TagsAttributeRequired(),
+		},
+	}
+}
+
+func (r *tagsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tagsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceARN := data.ResourceARN.ValueString()
+	if err := r.syncTags(ctx, resourceARN, tftags.New(ctx, data.Tags)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating resource (%s) exclusive tags", resourceARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tagsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tagsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceARN := data.ResourceARN.ValueString()
+	tags, err := r.findTags(ctx, resourceARN)
+
+	if isResourceNotFoundError(err) {
+		tflog.Warn(ctx, "Resource not found, removing exclusive tags from state", map[string]any{
+			names.AttrResourceARN: resourceARN,
+		})
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading resource (%s) exclusive tags", resourceARN), err.Error())
+
+		return
+	}
+
+	data.Tags = tftags.FlattenStringValueMap(ctx, tags.Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tagsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new tagsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Tags.Equal(old.Tags) {
+		resourceARN := new.ResourceARN.ValueString()
+		if err := r.syncTags(ctx, resourceARN, tftags.New(ctx, new.Tags)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating resource (%s) exclusive tags", resourceARN), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *tagsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrResourceARN), request, response)
+}
+
+// findTags returns the resource's tags that are subject to exclusive management.
+// AWS-reserved tags and tags ignored by the provider's ignore_tags configuration are excluded.
+func (r *tagsExclusiveResource) findTags(ctx context.Context, resourceARN string) (tftags.KeyValueTags, error) {
+	ignoreTagsConfig := r.Meta().IgnoreTagsConfig(ctx)
+
+	ctx, target, err := taggingContext(ctx, r.Meta(), resourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := target.listTags(ctx, r.Meta())
+	if err != nil {
+		return nil, err
+	}
+
+	return tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig), nil
+}
+
+// syncTags handles keeping the configured tags in sync with the remote resource.
+//
+// Tags configured on this resource but not on the resource, or with a different value, will be added.
+// Tags on the resource but not configured on this resource will be removed.
+func (r *tagsExclusiveResource) syncTags(ctx context.Context, resourceARN string, want tftags.KeyValueTags) error {
+	have, err := r.findTags(ctx, resourceARN)
+	if err != nil {
+		return err
+	}
+
+	if len(have.Removed(want)) == 0 && len(have.Updated(want)) == 0 {
+		return nil
+	}
+
+	ctx, target, err := taggingContext(ctx, r.Meta(), resourceARN)
+	if err != nil {
+		return err
+	}
+
+	return target.updateTags(ctx, r.Meta(), have, want)
+}
+
+type tagsExclusiveResourceModel struct {
+	framework.WithAssumeRoleARNModel
+	ResourceARN fwtypes.ARN `tfsdk:"resource_arn"`
+	Tags        tftags.Map  `tfsdk:"tags"`
+}
@@ -33,6 +33,8 @@ const (
 )
 
 // @SDKResource("aws_ram_resource_share_accepter", name="Resource Share Accepter")
+// @AssumeRoleOverride
+// @Permissions(create="ram:AcceptResourceShareInvitation", delete="ram:DisassociateResourceShare")
 func resourceResourceShareAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourceShareAccepterCreate,
@@ -65,6 +65,15 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_ram_resource_share_accepter",
 			Name:     "Resource Share Accepter",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Account:  unique.Make(inttypes.ResourceAccountAssumeRoleOverrideEnabled()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"ram:AcceptResourceShareInvitation",
+				},
+				Delete: []string{
+					"ram:DisassociateResourceShare",
+				},
+			},
 		},
 		{
 			Factory:  resourceSharingWithOrganization,
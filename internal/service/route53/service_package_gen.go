@@ -168,6 +168,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_route53_zone_association",
 			Name:     "Zone Association",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+			Account:  unique.Make(inttypes.ResourceAccountAssumeRoleOverrideEnabled()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"route53:AssociateVPCWithHostedZone",
+					"ec2:DescribeVpcs",
+				},
+				Delete: []string{
+					"route53:DisassociateVPCFromHostedZone",
+				},
+			},
 		},
 	}
 }
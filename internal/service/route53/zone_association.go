@@ -27,6 +27,8 @@ import (
 )
 
 // @SDKResource("aws_route53_zone_association", name="Zone Association")
+// @AssumeRoleOverride
+// @Permissions(create="route53:AssociateVPCWithHostedZone;ec2:DescribeVpcs", delete="route53:DisassociateVPCFromHostedZone")
 func resourceZoneAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceZoneAssociationCreate,
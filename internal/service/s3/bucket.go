@@ -50,6 +50,8 @@ const (
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
 // @Tags(identifierAttribute="bucket", resourceType="Bucket")
+// @Permissions(create="s3:CreateBucket", delete="s3:DeleteBucket")
+// @ListResource(operation="ListBuckets", items="Buckets", id="Name", idIsName=true, input="listBucketsInputForRegion", nameAttribute="bucket", namePrefixInput="Prefix")
 // @IdentityAttribute("bucket")
 // @CustomImport
 // @V60SDKv2Fix
@@ -1681,6 +1683,13 @@ func findBucketRegion(ctx context.Context, c *conns.AWSClient, bucket string, op
 	return region, nil
 }
 
+// listBucketsInputForRegion returns the input for listing the general purpose buckets in the client's Region.
+func listBucketsInputForRegion(ctx context.Context, c *conns.AWSClient) s3.ListBucketsInput {
+	return s3.ListBucketsInput{
+		BucketRegion: aws.String(c.Region(ctx)),
+	}
+}
+
 func retryWhenNoSuchBucketError[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
 	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func(ctx context.Context) (any, error) {
 		return f()
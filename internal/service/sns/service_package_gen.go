@@ -72,6 +72,17 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				IdentifierAttribute: names.AttrARN,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"sns:CreateTopic",
+				},
+				Update: []string{
+					"sns:SetTopicAttributes",
+				},
+				Delete: []string{
+					"sns:DeleteTopic",
+				},
+			},
 			Identity: inttypes.RegionalARNIdentity(
 				inttypes.WithIdentityDuplicateAttrs(names.AttrID),
 			),
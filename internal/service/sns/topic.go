@@ -215,6 +215,7 @@ var (
 
 // @SDKResource("aws_sns_topic", name="Topic")
 // @Tags(identifierAttribute="arn")
+// @Permissions(create="sns:CreateTopic", update="sns:SetTopicAttributes", delete="sns:DeleteTopic")
 // @ArnIdentity
 // @Testing(preIdentityVersion="v6.4.0")
 // @Testing(existsType="map[string]string")
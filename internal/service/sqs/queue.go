@@ -194,6 +194,8 @@ var (
 
 // @SDKResource("aws_sqs_queue", name="Queue")
 // @Tags(identifierAttribute="id")
+// @Permissions(create="sqs:CreateQueue", update="sqs:SetQueueAttributes", delete="sqs:DeleteQueue")
+// @ListResource(operation="ListQueues", items="QueueUrls", namePrefixInput="QueueNamePrefix")
 // @IdentityVersion(1)
 // @CustomInherentRegionIdentity("url", "parseQueueURL")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sqs/types;awstypes;map[awstypes.QueueAttributeName]string")
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSQSQueue_List_Basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resourceName1 := "aws_sqs_queue.test[0]"
+	resourceName2 := "aws_sqs_queue.test[1]"
+	resourceName3 := "aws_sqs_queue.test[2]"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	url1 := tfstatecheck.StateValue()
+	url2 := tfstatecheck.StateValue()
+	url3 := tfstatecheck.StateValue()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.SQSServiceID),
+		CheckDestroy: testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Queue/list_basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName: config.StringVariable(rName),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					url1.GetStateValue(resourceName1, tfjsonpath.New(names.AttrURL)),
+
+					url2.GetStateValue(resourceName2, tfjsonpath.New(names.AttrURL)),
+
+					url3.GetStateValue(resourceName3, tfjsonpath.New(names.AttrURL)),
+				},
+			},
+
+			// Step 2: Query
+			{
+				Query:                    true,
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Queue/list_basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName: config.StringVariable(rName),
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("aws_sqs_queue.test", map[string]knownvalue.Check{
+						names.AttrURL: url1.Value(),
+					}),
+
+					querycheck.ExpectIdentity("aws_sqs_queue.test", map[string]knownvalue.Check{
+						names.AttrURL: url2.Value(),
+					}),
+
+					querycheck.ExpectIdentity("aws_sqs_queue.test", map[string]knownvalue.Check{
+						names.AttrURL: url3.Value(),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_List_NamePrefixAndTags(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resourceNameExpected1 := "aws_sqs_queue.expected[0]"
+	resourceNameExpected2 := "aws_sqs_queue.expected[1]"
+	resourceNameNotExpected1 := "aws_sqs_queue.not_expected[0]"
+	resourceNameNotExpected2 := "aws_sqs_queue.not_expected[1]"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	expected1 := tfstatecheck.StateValue()
+	expected2 := tfstatecheck.StateValue()
+	notExpected1 := tfstatecheck.StateValue()
+	notExpected2 := tfstatecheck.StateValue()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.SQSServiceID),
+		CheckDestroy: testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Queue/list_name_prefix_and_tags/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName: config.StringVariable(rName),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					expected1.GetStateValue(resourceNameExpected1, tfjsonpath.New(names.AttrURL)),
+
+					expected2.GetStateValue(resourceNameExpected2, tfjsonpath.New(names.AttrURL)),
+
+					notExpected1.GetStateValue(resourceNameNotExpected1, tfjsonpath.New(names.AttrURL)),
+
+					notExpected2.GetStateValue(resourceNameNotExpected2, tfjsonpath.New(names.AttrURL)),
+				},
+			},
+
+			// Step 2: Query
+			{
+				Query:                    true,
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Queue/list_name_prefix_and_tags/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName: config.StringVariable(rName),
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("aws_sqs_queue.test", map[string]knownvalue.Check{
+						names.AttrURL: expected1.Value(),
+					}),
+
+					querycheck.ExpectIdentity("aws_sqs_queue.test", map[string]knownvalue.Check{
+						names.AttrURL: expected2.Value(),
+					}),
+
+					querycheck.ExpectNoIdentity("aws_sqs_queue.test", map[string]knownvalue.Check{
+						names.AttrURL: notExpected1.Value(),
+					}),
+
+					querycheck.ExpectNoIdentity("aws_sqs_queue.test", map[string]knownvalue.Check{
+						names.AttrURL: notExpected2.Value(),
+					}),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_sqs_queue" "expected" {
+  count = 2
+
+  name = "${var.rName}-${count.index}"
+
+  tags = {
+    Environment = "production"
+  }
+}
+
+resource "aws_sqs_queue" "not_expected" {
+  count = 2
+
+  name = "${var.rName}-not-expected-${count.index}"
+
+  tags = {
+    Environment = "development"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -31,6 +31,7 @@ import (
 
 // @SDKResource("aws_ssm_parameter", name="Parameter")
 // @Tags(identifierAttribute="id", resourceType="Parameter")
+// @Permissions(create="ssm:PutParameter", update="ssm:PutParameter", delete="ssm:DeleteParameter")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ssm/types;awstypes;awstypes.Parameter")
 // @Testing(importIgnore="has_value_wo")
 // @IdentityAttribute("name")
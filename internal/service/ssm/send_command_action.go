@@ -0,0 +1,502 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// sendCommandPollInterval defines polling cadence for the send command action.
+	sendCommandPollInterval = 5 * time.Second
+
+	// sendCommandMaxErrorContentLength is the maximum number of characters of an invocation's standard error included in diagnostics.
+	sendCommandMaxErrorContentLength = 2000
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandActionModel]
+}
+
+type sendCommandActionModel struct {
+	framework.WithRegionModel
+	Comment         types.String                                            `tfsdk:"comment"`
+	DocumentName    types.String                                            `tfsdk:"document_name"`
+	DocumentVersion types.String                                            `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListOfString                                    `tfsdk:"instance_ids"`
+	MaxConcurrency  types.String                                            `tfsdk:"max_concurrency"`
+	MaxErrors       types.String                                            `tfsdk:"max_errors"`
+	Parameters      types.Map                                               `tfsdk:"parameters"`
+	Targets         fwtypes.ListNestedObjectValueOf[sendCommandTargetModel] `tfsdk:"targets"`
+	Timeout         types.Int64                                             `tfsdk:"timeout"`
+}
+
+type sendCommandTargetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM document on managed nodes using Run Command and waits for all command invocations to complete.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "User-specified information about the command, such as a brief description of what the command should do",
+				Optional:    true,
+			},
+			"document_name": schema.StringAttribute{
+				Description: "Name or ARN of the SSM document to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "SSM document version to run",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Description: "IDs of the managed nodes where the command should run. Exactly one of instance_ids or targets must be specified.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(50),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "Maximum number of managed nodes that are allowed to run the command at the same time, as an absolute number or a percentage",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "Maximum number of errors allowed without the command failing, as an absolute number or a percentage",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "Parameters to pass to the SSM document",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the command to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sendCommandTargetModel](ctx),
+				Description: "Targets managed nodes by tag or resource group. Exactly one of instance_ids or targets must be specified.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "Target key, for example tag:Environment or resource-groups:Name",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Description: "Target values",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceIDs := fwflex.ExpandFrameworkStringValueList(ctx, config.InstanceIDs)
+	targets, diags := config.Targets.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate that exactly one of instance_ids or targets is provided
+	if (len(instanceIDs) == 0) == (len(targets) == 0) {
+		resp.Diagnostics.AddError(
+			"Invalid Command Targets",
+			"Exactly one of instance_ids or targets must be specified",
+		)
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		"instance_ids":    instanceIDs,
+		"targets":         len(targets),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending command for SSM document %s...", documentName),
+	})
+
+	input := ssm.SendCommandInput{
+		Comment:         fwflex.StringFromFramework(ctx, config.Comment),
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		InstanceIds:     instanceIDs,
+		MaxConcurrency:  fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:       fwflex.StringFromFramework(ctx, config.MaxErrors),
+	}
+
+	if !config.Parameters.IsNull() {
+		var parameters map[string][]string
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		input.Parameters = parameters
+	}
+
+	for _, target := range targets {
+		input.Targets = append(input.Targets, awstypes.Target{
+			Key:    target.Key.ValueStringPointer(),
+			Values: fwflex.ExpandFrameworkStringValueList(ctx, target.Values),
+		})
+	}
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send command for SSM document %s: %s", documentName, err),
+		)
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s sent, waiting for command invocations to complete...", commandID),
+	})
+
+	// Track the latest invocation of the command on each managed node so that
+	// progress is reported per node as its status changes.
+	invocations := make(map[string]*ssm.GetCommandInvocationOutput)
+	statuses := make(map[string]awstypes.CommandInvocationStatus)
+	var command *awstypes.Command
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		var err error
+		command, err = findCommandByID(ctx, conn, commandID)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("reading command: %w", err)
+		}
+
+		ids := instanceIDs
+		if len(ids) == 0 {
+			// The managed nodes matching the targets are only known once the command has been dispatched.
+			ids, err = findCommandInvocationInstanceIDs(ctx, conn, commandID)
+			if err != nil {
+				return actionwait.FetchResult[struct{}]{}, fmt.Errorf("listing command invocations: %w", err)
+			}
+
+			if len(ids) == 0 {
+				if isCommandComplete(command.Status) {
+					return actionwait.FetchResult[struct{}]{}, fmt.Errorf("no managed nodes matched the targets of command %s", commandID)
+				}
+
+				return actionwait.FetchResult[struct{}]{Status: actionwait.Status(awstypes.CommandStatusPending)}, nil
+			}
+		}
+
+		for _, id := range ids {
+			if isCommandInvocationComplete(statuses[id]) {
+				continue
+			}
+
+			invocation, err := findCommandInvocationByTwoPartKey(ctx, conn, commandID, id)
+			switch {
+			case tfresource.NotFound(err):
+				// The invocation has not yet been registered for this managed node.
+				continue
+			case err != nil:
+				return actionwait.FetchResult[struct{}]{}, fmt.Errorf("reading command invocation on %s: %w", id, err)
+			}
+
+			invocations[id] = invocation
+			if status := invocation.Status; status != statuses[id] {
+				statuses[id] = status
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Command %s on %s is in status '%s'", commandID, id, status),
+				})
+			}
+		}
+
+		return actionwait.FetchResult[struct{}]{Status: sendCommandStatus(command, ids, statuses)}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(sendCommandPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.CommandStatusSuccess)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+		},
+		FailureStates: []actionwait.Status{actionwait.Status(awstypes.CommandStatusFailed)},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Command %s is currently in status '%s', continuing to wait for completion...", commandID, fr.Status),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &failureErr) {
+			// Report each failed invocation separately.
+			for _, id := range slices.Sorted(maps.Keys(invocations)) {
+				if invocation := invocations[id]; invocation.Status != awstypes.CommandInvocationStatusSuccess {
+					resp.Diagnostics.AddError(
+						fmt.Sprintf("Command Invocation Failed on %s", id),
+						commandInvocationFailureDetail(commandID, invocation),
+					)
+				}
+			}
+
+			// The command can fail without a failed invocation, e.g. if it was not delivered to all of its targets.
+			if !resp.Diagnostics.HasError() {
+				resp.Diagnostics.AddError(
+					"Command Failed",
+					fmt.Sprintf("SSM command %s finished with status '%s' (%s)", commandID, command.Status, aws.ToString(command.StatusDetails)),
+				)
+			}
+		} else if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Command to Complete",
+				fmt.Sprintf("SSM command %s did not complete within %s: %s", commandID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Command Status",
+				fmt.Sprintf("SSM command %s entered unexpected status: %s", commandID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Command to Complete",
+				fmt.Sprintf("Error while waiting for SSM command %s to complete: %s", commandID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s completed successfully on %d managed node(s)", commandID, len(invocations)),
+	})
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id": commandID,
+	})
+}
+
+// sendCommandStatus returns the aggregate status of a command and its invocations on the specified managed nodes.
+// The command is complete once the command itself and every invocation is complete, and has failed if the command or any invocation did not succeed.
+func sendCommandStatus(command *awstypes.Command, instanceIDs []string, statuses map[string]awstypes.CommandInvocationStatus) actionwait.Status {
+	// A command that stops early, e.g. on reaching max_errors or being cancelled, may never be dispatched to all of its targets.
+	if isCommandComplete(command.Status) && command.Status != awstypes.CommandStatusSuccess {
+		return actionwait.Status(awstypes.CommandStatusFailed)
+	}
+
+	status := awstypes.CommandStatusSuccess
+
+	for _, id := range instanceIDs {
+		switch v, ok := statuses[id]; {
+		case !ok:
+			return actionwait.Status(awstypes.CommandStatusPending)
+		case !isCommandInvocationComplete(v):
+			return actionwait.Status(awstypes.CommandStatusInProgress)
+		case v != awstypes.CommandInvocationStatusSuccess:
+			status = awstypes.CommandStatusFailed
+		}
+	}
+
+	// Under rate control (max_concurrency), the command is dispatched to its targets in batches,
+	// so every invocation listed so far can be complete before the command is.
+	if !isCommandComplete(command.Status) || command.CompletedCount < command.TargetCount {
+		return actionwait.Status(awstypes.CommandStatusInProgress)
+	}
+
+	return actionwait.Status(status)
+}
+
+// isCommandComplete returns whether a command status is terminal.
+func isCommandComplete(status awstypes.CommandStatus) bool {
+	switch status {
+	case awstypes.CommandStatusSuccess,
+		awstypes.CommandStatusFailed,
+		awstypes.CommandStatusTimedOut,
+		awstypes.CommandStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// isCommandInvocationComplete returns whether a command invocation status is terminal.
+func isCommandInvocationComplete(status awstypes.CommandInvocationStatus) bool {
+	switch status {
+	case awstypes.CommandInvocationStatusSuccess,
+		awstypes.CommandInvocationStatusFailed,
+		awstypes.CommandInvocationStatusTimedOut,
+		awstypes.CommandInvocationStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func commandInvocationFailureDetail(commandID string, invocation *ssm.GetCommandInvocationOutput) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "SSM command %s finished with status '%s'", commandID, invocation.Status)
+	if v := aws.ToString(invocation.StatusDetails); v != "" && v != string(invocation.Status) {
+		fmt.Fprintf(&sb, " (%s)", v)
+	}
+	fmt.Fprintf(&sb, ", response code %d.", invocation.ResponseCode)
+
+	if v := aws.ToString(invocation.StandardErrorContent); v != "" {
+		if len(v) > sendCommandMaxErrorContentLength {
+			v = v[:sendCommandMaxErrorContentLength] + "..."
+		}
+		fmt.Fprintf(&sb, "\n\nStandard error:\n%s", v)
+	}
+
+	if v := aws.ToString(invocation.StandardErrorUrl); v != "" {
+		fmt.Fprintf(&sb, "\n\nFull standard error: %s", v)
+	}
+
+	return sb.String()
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := &ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(ctx, input)
+
+	if errs.IsA[*awstypes.InvalidCommandId](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Commands)
+}
+
+func findCommandInvocationByTwoPartKey(ctx context.Context, conn *ssm.Client, commandID, instanceID string) (*ssm.GetCommandInvocationOutput, error) {
+	input := &ssm.GetCommandInvocationInput{
+		CommandId:  aws.String(commandID),
+		InstanceId: aws.String(instanceID),
+	}
+
+	output, err := conn.GetCommandInvocation(ctx, input)
+
+	if errs.IsA[*awstypes.InvocationDoesNotExist](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocationInstanceIDs(ctx context.Context, conn *ssm.Client, commandID string) ([]string, error) {
+	input := &ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(commandID),
+	}
+	var output []string
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CommandInvocations {
+			output = append(output, aws.ToString(v.InstanceId))
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSendCommandAction_instanceIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSendCommandActionRegistrationSleep(),
+				),
+			},
+			{
+				Config: testAccSendCommandActionConfig_instanceIDs(rName, "echo hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandStatus(ctx, resourceName, awstypes.CommandStatusSuccess),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_targets(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSendCommandActionRegistrationSleep(),
+				),
+			},
+			{
+				Config: testAccSendCommandActionConfig_targets(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandStatus(ctx, resourceName, awstypes.CommandStatusSuccess),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_failure(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSendCommandActionRegistrationSleep(),
+				),
+			},
+			{
+				Config:      testAccSendCommandActionConfig_instanceIDs(rName, "echo failing >&2; exit 3"),
+				ExpectError: regexp.MustCompile(`(?s)Command Invocation Failed on i-.*response code 3.*failing`),
+			},
+		},
+	})
+}
+
+func testAccSendCommandActionRegistrationSleep() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+		time.Sleep(1 * time.Minute)
+		return nil
+	}
+}
+
+func TestSendCommandStatus(t *testing.T) {
+	t.Parallel()
+
+	instanceIDs := []string{"i-1", "i-2"}
+
+	testCases := map[string]struct {
+		command  awstypes.Command
+		statuses map[string]awstypes.CommandInvocationStatus
+		want     awstypes.CommandStatus
+	}{
+		"pending": {
+			command:  awstypes.Command{Status: awstypes.CommandStatusPending, TargetCount: 2},
+			statuses: map[string]awstypes.CommandInvocationStatus{},
+			want:     awstypes.CommandStatusPending,
+		},
+		"in progress": {
+			command: awstypes.Command{Status: awstypes.CommandStatusInProgress, TargetCount: 2, CompletedCount: 1},
+			statuses: map[string]awstypes.CommandInvocationStatus{
+				"i-1": awstypes.CommandInvocationStatusSuccess,
+				"i-2": awstypes.CommandInvocationStatusInProgress,
+			},
+			want: awstypes.CommandStatusInProgress,
+		},
+		"partially dispatched": {
+			command: awstypes.Command{Status: awstypes.CommandStatusInProgress, TargetCount: 4, CompletedCount: 2},
+			statuses: map[string]awstypes.CommandInvocationStatus{
+				"i-1": awstypes.CommandInvocationStatusSuccess,
+				"i-2": awstypes.CommandInvocationStatusSuccess,
+			},
+			want: awstypes.CommandStatusInProgress,
+		},
+		"partially counted": {
+			command: awstypes.Command{Status: awstypes.CommandStatusSuccess, TargetCount: 4, CompletedCount: 2},
+			statuses: map[string]awstypes.CommandInvocationStatus{
+				"i-1": awstypes.CommandInvocationStatusSuccess,
+				"i-2": awstypes.CommandInvocationStatusSuccess,
+			},
+			want: awstypes.CommandStatusInProgress,
+		},
+		"success": {
+			command: awstypes.Command{Status: awstypes.CommandStatusSuccess, TargetCount: 2, CompletedCount: 2},
+			statuses: map[string]awstypes.CommandInvocationStatus{
+				"i-1": awstypes.CommandInvocationStatusSuccess,
+				"i-2": awstypes.CommandInvocationStatusSuccess,
+			},
+			want: awstypes.CommandStatusSuccess,
+		},
+		"invocation failed": {
+			command: awstypes.Command{Status: awstypes.CommandStatusFailed, TargetCount: 2, CompletedCount: 2},
+			statuses: map[string]awstypes.CommandInvocationStatus{
+				"i-1": awstypes.CommandInvocationStatusSuccess,
+				"i-2": awstypes.CommandInvocationStatusFailed,
+			},
+			want: awstypes.CommandStatusFailed,
+		},
+		"invocation failed while in progress": {
+			command: awstypes.Command{Status: awstypes.CommandStatusInProgress, TargetCount: 4, CompletedCount: 2},
+			statuses: map[string]awstypes.CommandInvocationStatus{
+				"i-1": awstypes.CommandInvocationStatusSuccess,
+				"i-2": awstypes.CommandInvocationStatusFailed,
+			},
+			want: awstypes.CommandStatusInProgress,
+		},
+		"command failed": {
+			command: awstypes.Command{Status: awstypes.CommandStatusFailed, TargetCount: 4, CompletedCount: 2},
+			statuses: map[string]awstypes.CommandInvocationStatus{
+				"i-1": awstypes.CommandInvocationStatusSuccess,
+				"i-2": awstypes.CommandInvocationStatusSuccess,
+			},
+			want: awstypes.CommandStatusFailed,
+		},
+		"command failed before invocation registered": {
+			command: awstypes.Command{Status: awstypes.CommandStatusFailed, TargetCount: 2, CompletedCount: 1},
+			statuses: map[string]awstypes.CommandInvocationStatus{
+				"i-1": awstypes.CommandInvocationStatusSuccess,
+			},
+			want: awstypes.CommandStatusFailed,
+		},
+		"command cancelled": {
+			command: awstypes.Command{Status: awstypes.CommandStatusCancelled, TargetCount: 2, CompletedCount: 1},
+			statuses: map[string]awstypes.CommandInvocationStatus{
+				"i-1": awstypes.CommandInvocationStatusSuccess,
+				"i-2": awstypes.CommandInvocationStatusInProgress,
+			},
+			want: awstypes.CommandStatusFailed,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfssm.SendCommandStatus(&testCase.command, instanceIDs, testCase.statuses), actionwait.Status(testCase.want); got != want {
+				t.Errorf("SendCommandStatus() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func testAccCheckSendCommandStatus(ctx context.Context, n string, want awstypes.CommandStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		input := ssm.ListCommandsInput{
+			InstanceId: aws.String(rs.Primary.ID),
+		}
+		output, err := conn.ListCommands(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if len(output.Commands) == 0 {
+			return fmt.Errorf("no SSM commands found for %s", rs.Primary.ID)
+		}
+
+		if got := output.Commands[0].Status; got != want {
+			return fmt.Errorf("SSM command %s status = %s, want %s", aws.ToString(output.Commands[0].CommandId), got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccSendCommandActionConfig_instanceIDs(rName, command string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    instance_ids  = [aws_instance.test.id]
+    comment       = %[1]q
+    timeout       = 600
+
+    parameters = {
+      commands = [%[2]q]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName, command))
+}
+
+func testAccSendCommandActionConfig_targets(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    timeout       = 600
+
+    targets {
+      key    = "tag:Name"
+      values = [%[1]q]
+    }
+
+    parameters = {
+      commands = ["echo hello"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName))
+}
@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/url"
 	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -58,12 +59,46 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// ExcludeResourceTypes and ExcludeServices list the resources to which Tags are not defaulted.
+	// Rules still apply to these resources.
+	ExcludeResourceTypes []string
+	ExcludeServices      []string // Service package names.
+
+	// Rules contain additional tags to default across the resources they match.
+	// Use ForResource to resolve the tags that apply to a given resource.
+	Rules []DefaultTagsRule
+}
+
+// DefaultTagsRule contains tags to default across the resources matched by resource type and service package.
+// A resource matches if it matches any include matcher, or there are no include matchers, and matches no exclude matcher.
+type DefaultTagsRule struct {
+	Tags KeyValueTags
+
+	IncludeResourceTypes []string
+	IncludeServices      []string // Service package names.
+	ExcludeResourceTypes []string
+	ExcludeServices      []string // Service package names.
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+
+	// Rules remove tags by key and value, optionally only from certain resource types.
+	// Use ForResource to resolve the rules that apply to a given resource.
+	Rules []IgnoreTagsRule
+}
+
+// IgnoreTagsRule removes tags whose key matches any of Keys or KeyPrefixes, or any key if both are empty,
+// and whose value matches ValueRegex, if set.
+// If ResourceTypes is set, the rule applies only to those resource types.
+type IgnoreTagsRule struct {
+	Keys          []string
+	KeyPrefixes   []string
+	ValueRegex    *regexp.Regexp
+	ResourceTypes []string
 }
 
 // TagPolicyConfig contains options related to organizational tagging policies.
@@ -78,6 +113,10 @@ type TagPolicyConfig struct {
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// TagKeyPolicies are the tag key capitalization, allowed value and
+	// enforcement rules defined in the effective tag policy
+	TagKeyPolicies []TagKeyPolicy
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -86,6 +125,46 @@ type TagPolicyConfig struct {
 // across all these Go types, we convert them into this Go type.
 type KeyValueTags map[string]*TagData
 
+// Matches returns whether the rule applies to the specified resource type and service package.
+func (r DefaultTagsRule) Matches(typeName, servicePackageName string) bool {
+	if slices.Contains(r.ExcludeResourceTypes, typeName) || slices.Contains(r.ExcludeServices, servicePackageName) {
+		return false
+	}
+
+	if len(r.IncludeResourceTypes) == 0 && len(r.IncludeServices) == 0 {
+		return true
+	}
+
+	return slices.Contains(r.IncludeResourceTypes, typeName) || slices.Contains(r.IncludeServices, servicePackageName)
+}
+
+// ForResource returns the DefaultConfig that applies to the specified resource type and service package.
+// The tags of matching rules are merged, in order, over the configuration's Tags, unless the resource is excluded from them.
+// nil is returned if no tags apply.
+func (dc *DefaultConfig) ForResource(typeName, servicePackageName string) *DefaultConfig {
+	if dc == nil || (len(dc.Rules) == 0 && len(dc.ExcludeResourceTypes) == 0 && len(dc.ExcludeServices) == 0) {
+		return dc
+	}
+
+	var tags KeyValueTags
+	if !slices.Contains(dc.ExcludeResourceTypes, typeName) && !slices.Contains(dc.ExcludeServices, servicePackageName) {
+		tags = dc.Tags
+	}
+	for _, rule := range dc.Rules {
+		if rule.Matches(typeName, servicePackageName) {
+			tags = tags.Merge(rule.Tags)
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
+
 // GetTags is convenience method that returns the DefaultConfig's Tags, if any
 func (dc *DefaultConfig) GetTags() KeyValueTags {
 	if dc == nil {
@@ -147,9 +226,57 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
 
+	for _, rule := range config.Rules {
+		// Rules scoped to resource types only apply once resolved for a resource.
+		if len(rule.ResourceTypes) > 0 {
+			continue
+		}
+
+		for k, v := range result {
+			if rule.ignores(k, v.ValueString()) {
+				delete(result, k)
+			}
+		}
+	}
+
 	return result
 }
 
+// ForResource returns the IgnoreConfig that applies to the specified resource type.
+// Rules scoped to other resource types are removed.
+func (config *IgnoreConfig) ForResource(typeName string) *IgnoreConfig {
+	if config == nil || !slices.ContainsFunc(config.Rules, func(rule IgnoreTagsRule) bool { return len(rule.ResourceTypes) > 0 }) {
+		return config
+	}
+
+	result := &IgnoreConfig{
+		Keys:        config.Keys,
+		KeyPrefixes: config.KeyPrefixes,
+	}
+	for _, rule := range config.Rules {
+		switch {
+		case len(rule.ResourceTypes) == 0:
+		case slices.Contains(rule.ResourceTypes, typeName):
+			rule.ResourceTypes = nil
+		default:
+			continue
+		}
+		result.Rules = append(result.Rules, rule)
+	}
+
+	return result
+}
+
+func (rule IgnoreTagsRule) ignores(key, value string) bool {
+	if len(rule.Keys) > 0 || len(rule.KeyPrefixes) > 0 {
+		if !slices.Contains(rule.Keys, key) && !slices.ContainsFunc(rule.KeyPrefixes, func(prefix string) bool { return strings.HasPrefix(key, prefix) }) {
+			return false
+		}
+	}
+
+	return rule.ValueRegex == nil || rule.ValueRegex.MatchString(value)
+}
+
 // IgnoreElasticbeanstalk returns non-AWS and non-Elasticbeanstalk tag keys.
 func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 	result := make(KeyValueTags)
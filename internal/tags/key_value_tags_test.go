@@ -5,6 +5,7 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"slices"
 	"testing"
 
@@ -57,6 +58,179 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Owner": "team",
+		}),
+		Rules: []DefaultTagsRule{
+			{
+				Tags: New(ctx, map[string]string{
+					"Backup": "daily",
+				}),
+				IncludeResourceTypes: []string{"aws_db_instance", "aws_ebs_volume"},
+			},
+			{
+				Tags: New(ctx, map[string]string{
+					"Backup": "weekly",
+					"Tier":   "data",
+				}),
+				IncludeServices:      []string{"rds"},
+				ExcludeResourceTypes: []string{"aws_db_proxy"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		typeName           string
+		servicePackageName string
+		want               map[string]string
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_instance",
+		},
+		{
+			name: "no rules",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Owner": "team",
+				}),
+			},
+			typeName:           "aws_instance",
+			servicePackageName: "ec2",
+			want: map[string]string{
+				"Owner": "team",
+			},
+		},
+		{
+			name:               "no matching rules",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_instance",
+			servicePackageName: "ec2",
+			want: map[string]string{
+				"Owner": "team",
+			},
+		},
+		{
+			name:               "resource type match",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_ebs_volume",
+			servicePackageName: "ec2",
+			want: map[string]string{
+				"Backup": "daily",
+				"Owner":  "team",
+			},
+		},
+		{
+			name:               "later rule overrides",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_db_instance",
+			servicePackageName: "rds",
+			want: map[string]string{
+				"Backup": "weekly",
+				"Owner":  "team",
+				"Tier":   "data",
+			},
+		},
+		{
+			name:               "excluded resource type",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_db_proxy",
+			servicePackageName: "rds",
+			want: map[string]string{
+				"Owner": "team",
+			},
+		},
+		{
+			name: "all tags excluded",
+			defaultConfig: &DefaultConfig{
+				Rules: []DefaultTagsRule{
+					{
+						Tags: New(ctx, map[string]string{
+							"Owner": "team",
+						}),
+						ExcludeServices: []string{"autoscaling"},
+					},
+				},
+			},
+			typeName:           "aws_autoscaling_group",
+			servicePackageName: "autoscaling",
+		},
+		{
+			name: "base tags excluded by resource type",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Owner": "team",
+				}),
+				ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+			},
+			typeName:           "aws_autoscaling_group",
+			servicePackageName: "autoscaling",
+		},
+		{
+			name: "base tags excluded by service",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Owner": "team",
+				}),
+				ExcludeServices: []string{"autoscaling"},
+				Rules: []DefaultTagsRule{
+					{
+						Tags: New(ctx, map[string]string{
+							"Propagate": "true",
+						}),
+						IncludeResourceTypes: []string{"aws_autoscaling_group"},
+					},
+				},
+			},
+			typeName:           "aws_autoscaling_group",
+			servicePackageName: "autoscaling",
+			want: map[string]string{
+				"Propagate": "true",
+			},
+		},
+		{
+			name: "base tags not excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Owner": "team",
+				}),
+				ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+			},
+			typeName:           "aws_launch_template",
+			servicePackageName: "ec2",
+			want: map[string]string{
+				"Owner": "team",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.typeName, testCase.servicePackageName)
+
+			if testCase.want == nil {
+				if got != nil {
+					t.Fatalf("got %v, want nil", got.Tags)
+				}
+				return
+			}
+			if len(got.Rules) != 0 {
+				t.Errorf("got %d rules, want none", len(got.Rules))
+			}
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
@@ -578,6 +752,126 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreConfigRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &IgnoreConfig{
+		Keys: New(ctx, []string{"key1"}),
+		Rules: []IgnoreTagsRule{
+			{
+				ValueRegex: regexp.MustCompile(`^managed-by-`),
+			},
+			{
+				KeyPrefixes: []string{"backup:"},
+				ValueRegex:  regexp.MustCompile(`^\d+$`),
+			},
+			{
+				Keys:          []string{"Owner"},
+				ResourceTypes: []string{"aws_db_instance"},
+			},
+		},
+	}
+	tags := map[string]string{
+		"key1":         "value1",
+		"key2":         "managed-by-automation",
+		"backup:count": "7",
+		"backup:plan":  "daily",
+		"Owner":        "team",
+	}
+
+	testCases := []struct {
+		name     string
+		typeName string
+		want     map[string]string
+	}{
+		{
+			name: "unresolved",
+			want: map[string]string{
+				"backup:plan": "daily",
+				"Owner":       "team",
+			},
+		},
+		{
+			name:     "matching resource type",
+			typeName: "aws_db_instance",
+			want: map[string]string{
+				"backup:plan": "daily",
+			},
+		},
+		{
+			name:     "other resource type",
+			typeName: "aws_instance",
+			want: map[string]string{
+				"backup:plan": "daily",
+				"Owner":       "team",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ignoreConfig := config
+			if testCase.typeName != "" {
+				ignoreConfig = ignoreConfig.ForResource(testCase.typeName)
+			}
+
+			got := New(ctx, tags).IgnoreConfig(ignoreConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestIgnoreConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	unscoped := IgnoreTagsRule{
+		Keys: []string{"key2"},
+	}
+	scoped := IgnoreTagsRule{
+		Keys:          []string{"key3"},
+		ResourceTypes: []string{"aws_db_instance", "aws_rds_cluster"},
+	}
+	config := &IgnoreConfig{
+		Keys:  New(ctx, []string{"key1"}),
+		Rules: []IgnoreTagsRule{unscoped, scoped},
+	}
+
+	if got := (*IgnoreConfig)(nil).ForResource("aws_db_instance"); got != nil {
+		t.Errorf("nil config: got %v, want nil", got)
+	}
+
+	noScopedRules := &IgnoreConfig{
+		Rules: []IgnoreTagsRule{unscoped},
+	}
+	if got := noScopedRules.ForResource("aws_db_instance"); got != noScopedRules {
+		t.Errorf("no scoped rules: got %v, want unchanged config", got)
+	}
+
+	got := config.ForResource("aws_rds_cluster")
+	if !got.Keys.Equal(config.Keys) {
+		t.Errorf("matching resource type: got keys %v, want %v", got.Keys, config.Keys)
+	}
+	if got, want := len(got.Rules), 2; got != want {
+		t.Fatalf("matching resource type: got %d rules, want %d", got, want)
+	}
+	if got := got.Rules[1].ResourceTypes; got != nil {
+		t.Errorf("matching resource type: got resource types %v, want nil", got)
+	}
+
+	got = config.ForResource("aws_instance")
+	if got, want := len(got.Rules), 1; got != want {
+		t.Fatalf("other resource type: got %d rules, want %d", got, want)
+	}
+	if got, want := got.Rules[0].Keys, unscoped.Keys; !slices.Equal(got, want) {
+		t.Errorf("other resource type: got rule keys %v, want %v", got, want)
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TagKeyPolicy contains the effective organizational tag policy rules for a single tag key.
+type TagKeyPolicy struct {
+	// Key is the tag key with the capitalization required by the policy.
+	Key string
+
+	// Values are the allowed tag values.
+	// A value ending in "*" matches any value with that prefix.
+	// Any value is allowed if empty.
+	Values []string
+
+	// EnforcedFor is the set of Terraform resource type names for which
+	// noncompliant tagging operations are prevented by AWS.
+	EnforcedFor map[string]struct{}
+}
+
+// TagPolicyViolation describes a tag that does not comply with an organizational tag policy.
+type TagPolicyViolation struct {
+	// Key is the noncompliant tag key.
+	Key string
+
+	// Reason describes why the tag is noncompliant.
+	Reason string
+
+	// Enforced indicates whether AWS will reject the tagging operation.
+	Enforced bool
+}
+
+// TagPolicyViolationSummary is the diagnostic summary for a tag policy violation.
+const TagPolicyViolationSummary = "Noncompliant Tag"
+
+// Detail returns the diagnostic detail for the violation by the specified resource type.
+func (v TagPolicyViolation) Detail(typeName string) string {
+	detail := fmt.Sprintf("An organizational tag policy is not complied with by %s: %s.", typeName, v.Reason)
+	if v.Enforced {
+		detail += " The tag policy is enforced for this resource type and AWS will reject the tagging operation."
+	}
+	return detail
+}
+
+// HasTagKeyPolicies returns whether the configuration contains any tag key policies.
+func (c *TagPolicyConfig) HasTagKeyPolicies() bool {
+	return c != nil && len(c.TagKeyPolicies) > 0
+}
+
+// Violations returns the specified resource type's tags that do not comply with the
+// configured tag key policies, ordered by tag key.
+// Tag keys are matched to policies case-insensitively.
+func (c *TagPolicyConfig) Violations(typeName string, tags KeyValueTags) []TagPolicyViolation {
+	if !c.HasTagKeyPolicies() {
+		return nil
+	}
+
+	var violations []TagPolicyViolation
+
+	for k, v := range tags {
+		for _, policy := range c.TagKeyPolicies {
+			if !strings.EqualFold(k, policy.Key) {
+				continue
+			}
+
+			_, enforced := policy.EnforcedFor[typeName]
+
+			if k != policy.Key {
+				violations = append(violations, TagPolicyViolation{
+					Key:      k,
+					Reason:   fmt.Sprintf("tag key %q must be capitalized as %q", k, policy.Key),
+					Enforced: enforced,
+				})
+			}
+
+			if value := v.ValueString(); !policy.allowsValue(value) {
+				violations = append(violations, TagPolicyViolation{
+					Key:      k,
+					Reason:   fmt.Sprintf("tag %q value %q is not one of the allowed values %q", k, value, policy.Values),
+					Enforced: enforced,
+				})
+			}
+		}
+	}
+
+	slices.SortFunc(violations, func(a, b TagPolicyViolation) int {
+		return cmp.Or(cmp.Compare(a.Key, b.Key), cmp.Compare(a.Reason, b.Reason))
+	})
+
+	return violations
+}
+
+func (p TagKeyPolicy) allowsValue(value string) bool {
+	if len(p.Values) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(p.Values, func(allowed string) bool {
+		if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+			return strings.HasPrefix(value, prefix)
+		}
+		return value == allowed
+	})
+}
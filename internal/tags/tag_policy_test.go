@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTagPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &TagPolicyConfig{
+		TagKeyPolicies: []TagKeyPolicy{
+			{
+				Key:    "CostCenter",
+				Values: []string{"100", "200*"},
+				EnforcedFor: map[string]struct{}{
+					"aws_instance": {},
+				},
+			},
+			{
+				Key: "Project",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		config   *TagPolicyConfig
+		typeName string
+		tags     map[string]string
+		want     []TagPolicyViolation
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_instance",
+			tags:     map[string]string{"costcenter": "999"},
+		},
+		{
+			name:     "compliant",
+			config:   config,
+			typeName: "aws_instance",
+			tags:     map[string]string{"CostCenter": "100", "Project": "any", "Other": "value"},
+		},
+		{
+			name:     "compliant wildcard value",
+			config:   config,
+			typeName: "aws_instance",
+			tags:     map[string]string{"CostCenter": "200-a"},
+		},
+		{
+			name:     "invalid value enforced",
+			config:   config,
+			typeName: "aws_instance",
+			tags:     map[string]string{"CostCenter": "300"},
+			want: []TagPolicyViolation{
+				{Key: "CostCenter", Reason: `tag "CostCenter" value "300" is not one of the allowed values ["100" "200*"]`, Enforced: true},
+			},
+		},
+		{
+			name:     "invalid value not enforced",
+			config:   config,
+			typeName: "aws_vpc",
+			tags:     map[string]string{"CostCenter": "300"},
+			want: []TagPolicyViolation{
+				{Key: "CostCenter", Reason: `tag "CostCenter" value "300" is not one of the allowed values ["100" "200*"]`},
+			},
+		},
+		{
+			name:     "invalid capitalization",
+			config:   config,
+			typeName: "aws_vpc",
+			tags:     map[string]string{"costcenter": "100", "PROJECT": "any"},
+			want: []TagPolicyViolation{
+				{Key: "PROJECT", Reason: `tag key "PROJECT" must be capitalized as "Project"`},
+				{Key: "costcenter", Reason: `tag key "costcenter" must be capitalized as "CostCenter"`},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.Violations(testCase.typeName, New(ctx, testCase.tags))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTagPolicyViolationDetail(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		violation TagPolicyViolation
+		want      string
+	}{
+		{
+			name:      "not enforced",
+			violation: TagPolicyViolation{Key: "PROJECT", Reason: `tag key "PROJECT" must be capitalized as "Project"`},
+			want:      `An organizational tag policy is not complied with by aws_vpc: tag key "PROJECT" must be capitalized as "Project".`,
+		},
+		{
+			name:      "enforced",
+			violation: TagPolicyViolation{Key: "PROJECT", Reason: `tag key "PROJECT" must be capitalized as "Project"`, Enforced: true},
+			want:      `An organizational tag policy is not complied with by aws_vpc: tag key "PROJECT" must be capitalized as "Project". The tag policy is enforced for this resource type and AWS will reject the tagging operation.`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.violation.Detail("aws_vpc"), testCase.want; got != want {
+				t.Errorf("Detail() = %q, want %q", got, want)
+			}
+		})
+	}
+}
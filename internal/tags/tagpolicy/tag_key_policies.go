@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// allSupported is the enforced_for resource type suffix matching all of a service's resource types.
+	allSupported = "ALL_SUPPORTED"
+)
+
+// GetTagKeyPolicies returns the tag key rules from the account's effective tag policy.
+// No rules are returned if the account is not a member of an organization or no tag policy applies.
+func GetTagKeyPolicies(ctx context.Context, awsConfig aws.Config) ([]tftags.TagKeyPolicy, error) {
+	client := organizations.NewFromConfig(awsConfig)
+	input := organizations.DescribeEffectivePolicyInput{
+		PolicyType: types.EffectivePolicyTypeTagPolicy,
+	}
+	output, err := client.DescribeEffectivePolicy(ctx, &input)
+
+	if errs.IsA[*types.AWSOrganizationsNotInUseException](err) || errs.IsA[*types.EffectivePolicyNotFoundException](err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	return parseTagKeyPolicies(aws.ToString(output.EffectivePolicy.PolicyContent))
+}
+
+// effectiveTagPolicy is the JSON representation of an effective tag policy.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_tag-policies-syntax.html.
+type effectiveTagPolicy struct {
+	Tags map[string]struct {
+		TagKey      policyValue[string]   `json:"tag_key"`
+		TagValue    policyValue[[]string] `json:"tag_value"`
+		EnforcedFor policyValue[[]string] `json:"enforced_for"`
+	} `json:"tags"`
+}
+
+// policyValue is a tag policy value, optionally wrapped in an "@@assign" operator.
+type policyValue[T any] struct {
+	value T
+}
+
+func (v *policyValue[T]) UnmarshalJSON(b []byte) error {
+	var operators struct {
+		Assign *T `json:"@@assign"`
+	}
+	if err := json.Unmarshal(b, &operators); err == nil && operators.Assign != nil {
+		v.value = *operators.Assign
+		return nil
+	}
+
+	return json.Unmarshal(b, &v.value)
+}
+
+// parseTagKeyPolicies translates an effective tag policy into tag key rules.
+// enforced_for resource types are translated to Terraform resource type names;
+// resource types without a corresponding Terraform resource type are ignored.
+func parseTagKeyPolicies(content string) ([]tftags.TagKeyPolicy, error) {
+	var policy effectiveTagPolicy
+	if err := json.Unmarshal([]byte(content), &policy); err != nil {
+		return nil, err
+	}
+
+	var policies []tftags.TagKeyPolicy
+	for k, v := range policy.Tags {
+		p := tftags.TagKeyPolicy{
+			Key:         v.TagKey.value,
+			EnforcedFor: make(map[string]struct{}),
+		}
+		if p.Key == "" {
+			p.Key = k
+		}
+
+		// A lone "*" allows any value.
+		if values := v.TagValue.value; !slices.Equal(values, []string{"*"}) {
+			p.Values = values
+		}
+
+		for _, resourceType := range v.EnforcedFor.value {
+			if service, ok := strings.CutSuffix(resourceType, ":"+allSupported); ok {
+				for tagrisType, tfType := range Lookup {
+					if strings.HasPrefix(tagrisType, service+":") {
+						p.EnforcedFor[tfType] = struct{}{}
+					}
+				}
+			} else if tfType, ok := Lookup[resourceType]; ok {
+				p.EnforcedFor[tfType] = struct{}{}
+			}
+		}
+
+		policies = append(policies, p)
+	}
+
+	slices.SortFunc(policies, func(a, b tftags.TagKeyPolicy) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return policies, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestParseTagKeyPolicies(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content string
+		want    []tftags.TagKeyPolicy
+		wantErr bool
+	}{
+		"empty": {
+			content: `{}`,
+		},
+		"effective policy": {
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200*"],
+      "enforced_for": ["ec2:instance", "xray:ALL_SUPPORTED", "unknown:resource"]
+    },
+    "project": {
+      "tag_key": "Project",
+      "tag_value": ["*"]
+    }
+  }
+}`,
+			want: []tftags.TagKeyPolicy{
+				{
+					Key:    "CostCenter",
+					Values: []string{"100", "200*"},
+					EnforcedFor: map[string]struct{}{
+						"aws_instance":           {},
+						"aws_xray_group":         {},
+						"aws_xray_sampling_rule": {},
+					},
+				},
+				{
+					Key:         "Project",
+					EnforcedFor: map[string]struct{}{},
+				},
+			},
+		},
+		"assign operators": {
+			content: `{
+  "tags": {
+    "owner": {
+      "tag_key": {"@@assign": "Owner"},
+      "tag_value": {"@@assign": ["a", "b"]}
+    }
+  }
+}`,
+			want: []tftags.TagKeyPolicy{
+				{
+					Key:         "Owner",
+					Values:      []string{"a", "b"},
+					EnforcedFor: map[string]struct{}{},
+				},
+			},
+		},
+		"no tag_key": {
+			content: `{"tags": {"env": {}}}`,
+			want: []tftags.TagKeyPolicy{
+				{
+					Key:         "env",
+					EnforcedFor: map[string]struct{}{},
+				},
+			},
+		},
+		"invalid JSON": {
+			content: `{`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseTagKeyPolicies(testCase.content)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error: got %v, want error %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -38,6 +38,26 @@ func ResourceRegionDisabled() ServicePackageResourceRegion {
 	return ServicePackageResourceRegion{}
 }
 
+// ServicePackageResourceAccount represents resource-level AWS account information.
+type ServicePackageResourceAccount struct {
+	IsAssumeRoleOverrideEnabled bool // Is per-resource IAM Role (and so AWS account) override supported?
+}
+
+// ResourceAccountAssumeRoleOverrideEnabled returns the resource account configuration indicating that per-resource IAM Role override is supported.
+func ResourceAccountAssumeRoleOverrideEnabled() ServicePackageResourceAccount {
+	return ServicePackageResourceAccount{
+		IsAssumeRoleOverrideEnabled: true,
+	}
+}
+
+// ServicePackageResourcePermissions represents the IAM actions needed by a resource's Create, Update and Delete operations.
+// Actions needed by the Read operation are not listed as they are exercised when planning.
+type ServicePackageResourcePermissions struct {
+	Create []string
+	Update []string
+	Delete []string
+}
+
 // ServicePackageResourceTags represents resource-level tagging information.
 type ServicePackageResourceTags struct {
 	IdentifierAttribute string // The attribute for the identifier for UpdateTags etc.
@@ -75,13 +95,15 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   FrameworkImport
+	Factory     func(context.Context) (resource.ResourceWithConfigure, error)
+	TypeName    string
+	Name        string
+	Tags        unique.Handle[ServicePackageResourceTags]
+	Region      unique.Handle[ServicePackageResourceRegion]
+	Account     unique.Handle[ServicePackageResourceAccount]
+	Permissions ServicePackageResourcePermissions
+	Identity    Identity
+	Import      FrameworkImport
 }
 
 type ServicePackageFrameworkListResource struct {
@@ -106,13 +128,15 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   SDKv2Import
+	Factory     func() *schema.Resource
+	TypeName    string
+	Name        string
+	Tags        unique.Handle[ServicePackageResourceTags]
+	Region      unique.Handle[ServicePackageResourceRegion]
+	Account     unique.Handle[ServicePackageResourceAccount]
+	Permissions ServicePackageResourcePermissions
+	Identity    Identity
+	Import      SDKv2Import
 }
 
 type ListResourceForSDK interface {
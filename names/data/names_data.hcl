@@ -5660,7 +5660,7 @@ service "meta" {
   }
 
   provider_package_correct = "meta"
-  doc_prefix               = ["arn", "ip_ranges", "billing_service_account", "default_tags", "partition", "region", "service\\.", "service_principal"]
+  doc_prefix               = ["arn", "ip_ranges", "billing_service_account", "default_tags", "partition", "region", "resource_tags", "service\\.", "service_principal", "tags_exclusive"]
   exclude                  = true
   allowed_subcategory      = true
   note                     = "Not an AWS service (metadata)"
@@ -167,10 +167,46 @@ func PartitionForRegion(region string) endpoints.Partition {
 	return PartitionForRegion(endpoints.UsEast1RegionID)
 }
 
+// ServicePrincipalSuffixForPartition returns the DNS suffix of the service principal name
+// for the given service in the given partition.
+//
+// SPN region unique taken from
+// https://github.com/aws/aws-cdk/blob/main/packages/aws-cdk-lib/region-info/lib/default.ts
+func ServicePrincipalSuffixForPartition(service string, partition endpoints.Partition) string {
+	if partitionID := partition.ID(); service != "" && partitionID != endpoints.AwsPartitionID {
+		switch partitionID {
+		case endpoints.AwsIsoPartitionID:
+			switch service {
+			case "cloudhsm",
+				"config",
+				"logs",
+				"workspaces":
+				return partition.DNSSuffix()
+			}
+		case endpoints.AwsIsoBPartitionID:
+			switch service {
+			case "dms",
+				"logs":
+				return partition.DNSSuffix()
+			}
+		case endpoints.AwsCnPartitionID:
+			switch service {
+			case "codedeploy",
+				"elasticmapreduce",
+				"logs":
+				return partition.DNSSuffix()
+			}
+		}
+	}
+
+	return "amazonaws.com"
+}
+
 // Type ServiceDatum corresponds closely to attributes and blocks in `data/names_data.hcl` and are
 // described in detail in README.md.
 type serviceDatum struct {
 	aliases           []string
+	arnNamespace      string
 	brand             string
 	humanFriendly     string
 	providerNameUpper string
@@ -209,6 +245,7 @@ func readHCLIntoServiceData() error {
 		p := l.ProviderPackage()
 
 		sd := serviceDatum{
+			arnNamespace:      l.ARNNamespace(),
 			brand:             l.Brand(),
 			humanFriendly:     l.HumanFriendly(),
 			providerNameUpper: l.ProviderNameUpper(),
@@ -238,6 +275,22 @@ func ProviderPackageForAlias(serviceAlias string) (string, error) {
 	return "", fmt.Errorf("unable to find service for service alias %s", serviceAlias)
 }
 
+// ProviderPackagesForARNNamespace returns the service packages whose resources have ARNs in the specified namespace, e.g. "rds".
+// The packages are returned in lexical order.
+func ProviderPackagesForARNNamespace(namespace string) []string {
+	var packages []string
+
+	for k, v := range serviceData {
+		if v.arnNamespace != "" && v.arnNamespace == namespace {
+			packages = append(packages, k)
+		}
+	}
+
+	slices.Sort(packages)
+
+	return packages
+}
+
 func ProviderPackages() []string {
 	keys := make([]string, len(serviceData))
 
@@ -297,9 +350,12 @@ func HumanFriendly(service string) (string, error) {
 }
 
 const (
-	ResourceTopLevelRegionAttributeDescription     = `Region where this resource will be [managed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). ` + topLevelRegionDefaultDescription
-	ListResourceTopLevelRegionAttributeDescription = `Region to [query](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints) for resources of this type. ` + topLevelRegionDefaultDescription
-	ActionTopLevelRegionAttributeDescription       = `Region where this action will be [executed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). ` + topLevelRegionDefaultDescription
+	ResourceTopLevelRegionAttributeDescription      = `Region where this resource will be [managed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). ` + topLevelRegionDefaultDescription
+	ListResourceTopLevelRegionAttributeDescription  = `Region to [query](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints) for resources of this type. ` + topLevelRegionDefaultDescription
+	ListResourceTopLevelRegionsAttributeDescription = `Regions to [query](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints) for resources of this type. Use "*" to query all Regions enabled for the account.`
+	ActionTopLevelRegionAttributeDescription        = `Region where this action will be [executed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). ` + topLevelRegionDefaultDescription
+
+	ResourceTopLevelAssumeRoleARNAttributeDescription = `ARN of an IAM Role to assume when managing this resource, e.g. in another AWS account. Defaults to the credentials set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
 
 	topLevelRegionDefaultDescription = `Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
 )
@@ -60,6 +60,58 @@ func TestPartitionForRegion(t *testing.T) {
 	}
 }
 
+func TestServicePrincipalSuffixForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		service  string
+		region   string
+		expected string
+	}{
+		{
+			name:     "standard",
+			service:  "logs",
+			region:   endpoints.UsWest2RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "China unique",
+			service:  "logs",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "amazonaws.com.cn",
+		},
+		{
+			name:     "China",
+			service:  "ec2",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "GovCloud",
+			service:  "logs",
+			region:   endpoints.UsGovWest1RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "ISO unique",
+			service:  "config",
+			region:   endpoints.UsIsoEast1RegionID,
+			expected: "c2s.ic.gov",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ServicePrincipalSuffixForPartition(testCase.service, PartitionForRegion(testCase.region)), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
 func TestProviderPackageForAlias(t *testing.T) {
 	t.Parallel()
 
@@ -110,6 +162,47 @@ func TestProviderPackageForAlias(t *testing.T) {
 	}
 }
 
+func TestProviderPackagesForARNNamespace(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected []string
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+		},
+		{
+			TestName: "unknown",
+			Input:    "nosuchservice",
+		},
+		{
+			TestName: "different package name",
+			Input:    "states",
+			Expected: []string{SFN},
+		},
+		{
+			TestName: "shared",
+			Input:    "rds",
+			Expected: []string{DocDB, Neptune, RDS},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got := ProviderPackagesForARNNamespace(testCase.Input)
+
+			if !slices.Equal(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestServicesForDirectories(t *testing.T) {
 	t.Parallel()
 